@@ -15,7 +15,7 @@ func bumpCommand(cl *changelog.Changelog) {
 	}
 
 	if bump == BumpAuto {
-		majority := unreleased.GetMajority()
+		majority := unreleased.GetMajority(breakingMarkers.Values()...)
 		if majority == changelog.NoChanges {
 			Usage("Changelog does not contain unreleased changes")
 			os.Exit(1)
@@ -37,10 +37,33 @@ func bumpCommand(cl *changelog.Changelog) {
 		version = latestVersion.BumpMajor()
 	}
 
+	links, hasLinks := getCompareLinks(cl)
+
 	if err := cl.Release(version); err != nil {
 		Usage(fmt.Sprintf("Unable to make release: %v", err))
 		os.Exit(1)
 	}
 
-	fmt.Println(cl.ToMarkdown())
+	if hasLinks {
+		cl.UpdateLinks(links, version, changelog.Unreleased)
+	}
+
+	updateVersionFiles(version)
+
+	printChangelog(cl)
+}
+
+// getCompareLinks returns the config of compare links of versions from params or detects it by existing links.
+// Links are maintained only if the repository URL is known
+func getCompareLinks(cl *changelog.Changelog) (changelog.CompareLinks, bool) {
+	if repositoryURL != "" {
+		return changelog.CompareLinks{RepositoryURL: repositoryURL, TagPrefix: tagPrefix}, true
+	}
+
+	links, ok := cl.DetectCompareLinks()
+	if ok && isFlagPassed("tag-prefix") {
+		links.TagPrefix = tagPrefix
+	}
+
+	return links, ok
 }
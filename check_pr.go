@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+func checkPRCommand(cl *changelog.Changelog) {
+	baseContent, err := gitShowFile(base, filepath)
+	if err != nil {
+		Usage(fmt.Sprintf("Unable to read changelog at revision %s: %v\n", base, err))
+		os.Exit(1)
+	}
+
+	isReleaseBranch, err := checkReleaseBranch()
+	if err != nil {
+		Usage(fmt.Sprintf("Unable to detect current branch: %v\n", err))
+		os.Exit(1)
+	}
+
+	baseChangelog, err := changelog.Parse(baseContent, readOptions...)
+	if err != nil {
+		Usage(fmt.Sprintf("Unable to parse changelog at revision %s: %v\n", base, err))
+		os.Exit(1)
+	}
+
+	problems := changelog.CheckPR(baseChangelog, cl, isReleaseBranch)
+
+	for _, problem := range problems {
+		fmt.Println(problem)
+	}
+
+	if len(problems) > 0 {
+		os.Exit(1)
+	}
+}
+
+// checkReleaseBranch reports whether the current branch matches -release-branch pattern
+func checkReleaseBranch() (bool, error) {
+	re, err := regexp.Compile(releaseBranch)
+	if err != nil {
+		return false, err
+	}
+
+	current := branch
+	if current == "" {
+		current, err = gitCurrentBranch()
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return re.MatchString(current), nil
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+func compareCommand(cl *changelog.Changelog) {
+	otherChangelog, err := readChangelog(other)
+	if err != nil {
+		Usage(fmt.Sprintf("Unable to read other changelog file: %v\n", err))
+		os.Exit(1)
+	}
+
+	comparison := changelog.Compare(cl, otherChangelog)
+
+	for _, ver := range comparison.AddedVersions {
+		fmt.Printf("Version %s was added\n", ver.GetVersion())
+	}
+
+	for _, ver := range comparison.RemovedVersions {
+		fmt.Printf("Version %s was removed\n", ver.GetVersion())
+	}
+
+	for _, changed := range comparison.ChangedVersions {
+		fmt.Printf("Version %s was changed:\n", changed.Version.GetVersion())
+		for _, line := range changed.Describe() {
+			fmt.Printf("  %s\n", line)
+		}
+	}
+}
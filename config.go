@@ -0,0 +1,121 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+	"github.com/s-larionov/changelog-cli/pkg/convert"
+)
+
+// Params of reading and rendering changelogs shared by commands
+var (
+	strict           bool
+	duplicatesPolicy changelog.DuplicatesPolicy
+	kinds            = changelog.NewKindResolver(changelog.KindAliases)
+	kindAliases      = newStringsFlag(nil)
+	aliases          = make(map[string]changelog.ChangesKind)
+	breakingMarkers  = newStringsFlag(changelog.BreakingMarkers)
+	refPatterns      = newStringsFlag(nil)
+	linkTemplates    = newStringsFlag(nil)
+	groupBreaking    bool
+	locale           string
+	duplicatesSrc    string
+
+	// readOptions are options of reading changelogs
+	readOptions []changelog.Option
+	// renderOptions are options of rendering changelogs for displaying
+	renderOptions changelog.RenderOptions
+)
+
+// registerChangelogFlags defines flags of reading and rendering changelogs
+func registerChangelogFlags() {
+	flag.BoolVar(&strict, "strict", false, "Fail on content which doesn't fit the structure of the changelog (e.g. headings of invalid versions)")
+	flag.StringVar(&duplicatesSrc, "duplicates", string(changelog.DuplicatesMerge), "Policy for repeated sections of the same version or kind of changes (merge, error)")
+	flag.Var(kindAliases, "kind-alias", "Alias of the kind of changes in format 'Heading=Kind' (e.g. 'Bug Fixes=Fixed', can be passed several times)")
+	flag.Var(breakingMarkers, "breaking-marker", "Prefix of entries describing breaking changes (can be passed several times)")
+	flag.Var(refPatterns, "ref-pattern", "Pattern of references in format 'kind=regexp' for refs command, replaces default patterns (can be passed several times)")
+	flag.Var(linkTemplates, "link-template", "URL template for references in format '[kind=]https://tracker.local/browse/{id}' (can be passed several times)")
+	flag.BoolVar(&groupBreaking, "group-breaking", true, "Show breaking changes in the dedicated group in diff and render commands")
+	flag.StringVar(&locale, "locale", "", "Locale of headings of kinds in diff and render commands (en, ru, de)")
+}
+
+// parseChangelogFlags validates flags of reading and rendering changelogs and fills readOptions and renderOptions
+func parseChangelogFlags() {
+	var err error
+
+	duplicatesPolicy, err = changelog.NewDuplicatesPolicy(duplicatesSrc)
+	if err != nil {
+		Usage(fmt.Sprintf("Wrong duplicates parameter: %v\n", err))
+		os.Exit(1)
+	}
+
+	for _, alias := range kindAliases.Values() {
+		heading, kindName, ok := strings.Cut(alias, "=")
+		if !ok || strings.TrimSpace(heading) == "" || strings.TrimSpace(kindName) == "" {
+			Usage(fmt.Sprintf("Wrong format for 'kind-alias' %s\n", alias))
+			os.Exit(1)
+		}
+
+		aliases[heading] = changelog.ResolveKind(kindName)
+	}
+	kinds = changelog.NewKindResolver(changelog.KindAliases, aliases)
+
+	if _, ok := changelog.KindNames[locale]; locale != "" && !ok {
+		Usage(fmt.Sprintf("Wrong locale parameter: %v\n", locale))
+		os.Exit(1)
+	}
+
+	// Nil patterns mean default ones
+	var patterns []changelog.RefPattern
+	for _, src := range refPatterns.Values() {
+		pattern, err := changelog.NewRefPattern(src)
+		if err != nil {
+			Usage(fmt.Sprintf("Wrong format for 'ref-pattern' %s: %v\n", src, err))
+			os.Exit(1)
+		}
+
+		patterns = append(patterns, pattern)
+	}
+
+	readOptions = []changelog.Option{
+		changelog.WithStrict(strict),
+		changelog.WithDuplicates(duplicatesPolicy),
+		changelog.WithKindAliases(aliases),
+		changelog.WithBreakingMarkers(breakingMarkers.Values()...),
+		changelog.WithRefPatterns(patterns...),
+	}
+
+	renderOptions = changelog.RenderOptions{
+		GroupBreaking:   groupBreaking,
+		BreakingMarkers: breakingMarkers.Values(),
+		RefPatterns:     patterns,
+		Locale:          locale,
+	}
+
+	for _, template := range linkTemplates.Values() {
+		if renderOptions.LinkTemplates == nil {
+			renderOptions.LinkTemplates = make(map[changelog.RefKind]string)
+		}
+
+		// Template without kind is used for all kinds of references
+		refKind, url, ok := strings.Cut(template, "=")
+		if !ok || strings.ContainsAny(refKind, ":/") {
+			refKind, url = "", template
+		}
+
+		renderOptions.LinkTemplates[changelog.RefKind(refKind)] = url
+	}
+}
+
+// convertOptions returns options of converting changelogs of other formats
+func convertOptions() convert.Options {
+	opts := convert.Options{KindAliases: aliases}
+	if markers := breakingMarkers.Values(); len(markers) > 0 {
+		opts.BreakingMarker = markers[0]
+	}
+
+	return opts
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+	"github.com/s-larionov/changelog-cli/pkg/convert"
+)
+
+// convertCommand prints the changelog of another format (-from-format) in Keep a Changelog format
+func convertCommand() {
+	var content []byte
+	var err error
+
+	if strings.EqualFold(filepath, UseSTDIN) {
+		content, err = io.ReadAll(os.Stdin)
+	} else {
+		content, err = os.ReadFile(filepath)
+	}
+
+	if err != nil {
+		Usage(fmt.Sprintf("Unable to read changelog file: %v\n", err))
+		os.Exit(1)
+	}
+
+	cl, err := convert.Convert(fromFormat, content, convertOptions())
+	if err != nil {
+		Usage(fmt.Sprintf("Unable to convert changelog: %v\n", err))
+		os.Exit(1)
+	}
+
+	cl.Header, cl.Description = clDefaultHeader, clDefaultDescription
+	if _, ok := cl.GetChanges(changelog.Unreleased); !ok {
+		_ = cl.Add(changelog.Unreleased, changelog.NewChanges())
+	}
+
+	if links, ok := cl.DetectCompareLinks(); ok {
+		cl.UpdateLinks(links, changelog.Unreleased)
+	}
+
+	printChangelog(cl)
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+	"github.com/s-larionov/changelog-cli/pkg/debian"
+)
+
+// debianCommand prints released versions as debian/changelog stanzas
+func debianCommand(cl *changelog.Changelog) {
+	output, err := debian.Render(getFilteredSections(cl), debianOptions)
+	if err != nil {
+		Usage(fmt.Sprintf("Unable to render debian changelog: %v\n", err))
+		os.Exit(1)
+	}
+
+	fmt.Print(output)
+}
+
+// parseDebianParams fills debianOptions by passed params. Maintainer is taken from DEBFULLNAME and DEBEMAIL
+// environment variables by default as dch does
+func parseDebianParams(urgencies []string) {
+	debianOptions.Maintainer, debianOptions.Revision = maintainer, revision
+	if debianOptions.Maintainer == "" && os.Getenv("DEBFULLNAME") != "" && os.Getenv("DEBEMAIL") != "" {
+		debianOptions.Maintainer = fmt.Sprintf("%s <%s>", os.Getenv("DEBFULLNAME"), os.Getenv("DEBEMAIL"))
+	}
+
+	if debianOptions.Package == "" {
+		Usage("Parameter 'package' is required for debian command")
+		os.Exit(1)
+	}
+
+	if debianOptions.Maintainer == "" {
+		Usage("Parameter 'maintainer' (or DEBFULLNAME and DEBEMAIL environment variables) is required for debian command")
+		os.Exit(1)
+	}
+
+	if len(urgencies) == 0 {
+		return
+	}
+
+	debianOptions.Urgencies = make(map[changelog.ChangesKind]debian.Urgency)
+	for kind, urgency := range debian.DefaultUrgencies {
+		debianOptions.Urgencies[kind] = urgency
+	}
+
+	for _, src := range urgencies {
+		kindName, urgencyName, ok := strings.Cut(src, "=")
+		if !ok {
+			Usage(fmt.Sprintf("Wrong format for 'urgency' %s\n", src))
+			os.Exit(1)
+		}
+
+		urgency, err := debian.NewUrgency(urgencyName)
+		if err != nil {
+			Usage(fmt.Sprintf("Wrong format for 'urgency' %s: %v\n", src, err))
+			os.Exit(1)
+		}
+
+		debianOptions.Urgencies[kinds.Resolve(kindName)] = urgency
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+type deprecationsResult struct {
+	Pending       []deprecationItem `json:"pending"`
+	Removed       []deprecationItem `json:"removed"`
+	NotDeprecated []entryItem       `json:"not_deprecated"`
+}
+
+type deprecationItem struct {
+	Deprecated entryItem  `json:"deprecated"`
+	Removed    *entryItem `json:"removed,omitempty"`
+	Days       *int       `json:"days,omitempty"`
+}
+
+type entryItem struct {
+	Version changelog.VersionString `json:"version"`
+	Date    *time.Time              `json:"date,omitempty"`
+	Entry   string                  `json:"entry"`
+}
+
+func deprecationsCommand(cl *changelog.Changelog) {
+	report := cl.GetDeprecations()
+	now := time.Now()
+
+	result := deprecationsResult{
+		Pending:       make([]deprecationItem, 0, len(report.Pending)),
+		Removed:       make([]deprecationItem, 0, len(report.Removed)),
+		NotDeprecated: make([]entryItem, 0, len(report.NotDeprecated)),
+	}
+
+	for _, deprecation := range report.Pending {
+		result.Pending = append(result.Pending, newDeprecationItem(deprecation, now))
+	}
+
+	for _, deprecation := range report.Removed {
+		result.Removed = append(result.Removed, newDeprecationItem(deprecation, now))
+	}
+
+	for _, entry := range report.NotDeprecated {
+		result.NotDeprecated = append(result.NotDeprecated, newEntryItem(entry))
+	}
+
+	if format == FormatJSON {
+		printJSON(result)
+		return
+	}
+
+	fmt.Println("Pending removal:")
+	for _, item := range result.Pending {
+		fmt.Printf("  %s (deprecated for %s)\n", formatEntryItem(item.Deprecated), formatDays(item.Days))
+	}
+
+	fmt.Println()
+	fmt.Println("Removed after deprecation:")
+	for _, item := range result.Removed {
+		fmt.Printf("  %s => %s (deprecated for %s)\n", formatEntryItem(item.Deprecated), formatEntryItem(*item.Removed), formatDays(item.Days))
+	}
+
+	fmt.Println()
+	fmt.Println("Removed without deprecation:")
+	for _, item := range result.NotDeprecated {
+		fmt.Printf("  %s\n", formatEntryItem(item))
+	}
+}
+
+func newDeprecationItem(deprecation changelog.Deprecation, now time.Time) deprecationItem {
+	item := deprecationItem{
+		Deprecated: newEntryItem(deprecation.Deprecated),
+	}
+
+	if deprecation.Removed != nil {
+		removed := newEntryItem(*deprecation.Removed)
+		item.Removed = &removed
+	}
+
+	if days := deprecation.GetDays(now); days >= 0 {
+		item.Days = &days
+	}
+
+	return item
+}
+
+func newEntryItem(entry changelog.Entry) entryItem {
+	item := entryItem{
+		Version: entry.Version.GetVersion(),
+		Entry:   entry.Text,
+	}
+
+	if date := entry.Version.GetDate(); !date.IsZero() {
+		item.Date = &date
+	}
+
+	return item
+}
+
+func formatEntryItem(item entryItem) string {
+	return fmt.Sprintf("[%s] %s", item.Version, item.Entry)
+}
+
+func formatDays(days *int) string {
+	if days == nil {
+		return "unknown time"
+	}
+
+	return fmt.Sprintf("%d days", *days)
+}
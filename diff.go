@@ -8,24 +8,35 @@ import (
 )
 
 func diffCommand(cl *changelog.Changelog) {
+	output := cl.GetDiffByFilter(getDiffFilter(cl)).Render(renderOptions)
+
+	if output != "" {
+		fmt.Println(output)
+	}
+
+	if output == "" && failOnEmpty {
+		os.Exit(1)
+	}
+}
+
+// getDiffFilter returns the filter of versions selected by 'from' and 'to' params (or by range params)
+func getDiffFilter(cl *changelog.Changelog) changelog.VersionFilter {
+	if useVersionFilter {
+		return versionFilter
+	}
+
 	if from.IsLatest() {
 		from = cl.GetLatestVersion()
 	}
 
-	changes := cl.GetDiff(from, to)
-
 	// If from and to versions are the same then diff between them is changes in exactly this version
 	if from.Equal(to) {
-		changes, _ = cl.GetChanges(to)
+		return changelog.VersionFilter{From: to, To: to}
 	}
 
-	output := changes.ToMarkdown()
-
-	if output != "" {
-		fmt.Println(output)
+	if from.GreaterThan(to) {
+		return changelog.VersionFilter{From: to, To: from, ExcludeFrom: true}
 	}
 
-	if output == "" && failOnEmpty {
-		os.Exit(1)
-	}
+	return changelog.VersionFilter{From: from, To: to, ExcludeFrom: true}
 }
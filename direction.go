@@ -7,12 +7,6 @@ import (
 	"github.com/s-larionov/changelog-cli/pkg/changelog"
 )
 
-const (
-	Upgrade  = "UPGRADE"
-	Rollback = "ROLLBACK"
-	Redeploy = "REDEPLOY"
-)
-
 func getDirectionCommand(cl *changelog.Changelog) {
 	if to.IsUnrealized() || from.IsUnrealized() {
 		Usage("You have to specified 'from' and 'to' versions instead of using UNRELEASED keyword")
@@ -36,16 +30,6 @@ func getDirectionCommand(cl *changelog.Changelog) {
 		_, _ = fmt.Fprintf(os.Stderr, "[WARN] Version %s does not exist in CHANGELOG.md\n", to.GetVersion())
 	}
 
-	var direction string
-	switch {
-	case to.GreaterThan(from):
-		direction = Upgrade
-	case to.LessThen(from):
-		direction = Rollback
-	default:
-		direction = Redeploy
-	}
-
-	fmt.Println(direction)
+	fmt.Println(changelog.GetDirection(from, to))
 	os.Exit(0)
 }
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+func editCommand(cl *changelog.Changelog) {
+	ver := getTargetVersion(cl)
+
+	target := toVersion
+	switch {
+	case target.IsLatest():
+		target = cl.GetLatestVersion()
+	case target.IsUnrealized():
+		target = changelog.Unreleased
+	}
+
+	entry := changelog.Entry{Version: ver, Kind: kinds.Resolve(kind), Text: entryText}
+
+	var err error
+	switch command {
+	case RenameCommand:
+		err = renameVersion(cl, ver, newVersion)
+	case RedateCommand:
+		err = cl.SetDate(ver, date)
+	case MoveEntryCommand:
+		err = cl.MoveEntry(entry, target, kinds.Resolve(toKind))
+	case DeleteEntryCommand:
+		err = cl.DeleteEntry(entry)
+	}
+
+	if err != nil {
+		Usage(fmt.Sprintf("Unable to edit the changelog: %v", err))
+		os.Exit(1)
+	}
+
+	printChangelog(cl)
+}
+
+// renameVersion renames the version and rebuilds links of the version and of versions following
+// its old and new positions, like bump does
+func renameVersion(cl *changelog.Changelog, ver, newVer changelog.Version) error {
+	links, hasLinks := getCompareLinks(cl)
+	next, hasNext := cl.GetNextVersion(ver)
+
+	if err := cl.Rename(ver, newVer); err != nil {
+		return err
+	}
+
+	if !hasLinks {
+		return nil
+	}
+
+	versions := []changelog.Version{newVer}
+	if hasNext {
+		versions = append(versions, next)
+	}
+	if next, ok := cl.GetNextVersion(newVer); ok {
+		versions = append(versions, next)
+	}
+
+	cl.UpdateLinks(links, append(versions, changelog.Unreleased)...)
+
+	return nil
+}
+
+// parseEditParams validates params of rename, redate, move-entry and delete-entry commands
+func parseEditParams(versionSrc string) {
+	if versionSrc == "" {
+		Usage(fmt.Sprintf("Parameter 'version' is required for %s command", command))
+		os.Exit(1)
+	}
+
+	var err error
+	targetVersion, err = changelog.NewVersion(changelog.VersionString(versionSrc), nil)
+	if err != nil {
+		Usage(fmt.Sprintf("Wrong format for version: %v\n", err))
+		os.Exit(1)
+	}
+
+	switch command {
+	case RenameCommand:
+		newVersion, err = changelog.NewVersion(changelog.VersionString(newVersionString), nil)
+		if err != nil || newVersionString == "" {
+			Usage(fmt.Sprintf("Wrong format for 'new-version': %v\n", err))
+			os.Exit(1)
+		}
+	case RedateCommand:
+		date, err = time.Parse(dateLayout, dateString)
+		if err != nil {
+			Usage(fmt.Sprintf("Wrong format for 'date': %v\n", err))
+			os.Exit(1)
+		}
+	case MoveEntryCommand, DeleteEntryCommand:
+		if kind == "" || entryText == "" {
+			Usage(fmt.Sprintf("Parameters 'kind' and 'entry' are required for %s command", command))
+			os.Exit(1)
+		}
+
+		toVersion = targetVersion
+		if toVersionString != "" {
+			toVersion, err = changelog.NewVersion(changelog.VersionString(toVersionString), nil)
+			if err != nil {
+				Usage(fmt.Sprintf("Wrong format for 'to-version': %v\n", err))
+				os.Exit(1)
+			}
+		}
+
+		if toKind == "" {
+			toKind = kind
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Masterminds/semver"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+// parseVersionFilter fills versionFilter by passed 'range', 'from', 'to', 'since', 'until', 'constraint' and 'prerelease' params
+func parseVersionFilter() {
+	var err error
+
+	if rangeString != "" {
+		if isFlagPassed("from") || isFlagPassed("to") {
+			Usage("Parameter 'range' can't be combined with 'from' and 'to' parameters")
+			os.Exit(1)
+		}
+
+		versionFilter, err = changelog.NewVersionFilterFromRange(rangeString)
+		if err != nil {
+			Usage(fmt.Sprintf("Wrong format for 'range': %v\n", err))
+			os.Exit(1)
+		}
+	}
+
+	if isFlagPassed("from") {
+		versionFilter.From, err = changelog.NewVersion(changelog.VersionString(fromString), nil)
+		if err != nil {
+			Usage(fmt.Sprintf("Wrong format for 'from' version: %v\n", err))
+			os.Exit(1)
+		}
+	}
+
+	if isFlagPassed("to") {
+		versionFilter.To, err = changelog.NewVersion(changelog.VersionString(toString), nil)
+		if err != nil {
+			Usage(fmt.Sprintf("Wrong format for 'to' version: %v\n", err))
+			os.Exit(1)
+		}
+	}
+
+	if sinceString != "" {
+		versionFilter.Since, err = time.Parse(dateLayout, sinceString)
+		if err != nil {
+			Usage(fmt.Sprintf("Wrong format for 'since' date: %v\n", err))
+			os.Exit(1)
+		}
+	}
+
+	if untilString != "" {
+		versionFilter.Until, err = time.Parse(dateLayout, untilString)
+		if err != nil {
+			Usage(fmt.Sprintf("Wrong format for 'until' date: %v\n", err))
+			os.Exit(1)
+		}
+	}
+
+	if constraint != "" {
+		versionFilter.Constraint, err = semver.NewConstraint(constraint)
+		if err != nil {
+			Usage(fmt.Sprintf("Wrong format for 'constraint': %v\n", err))
+			os.Exit(1)
+		}
+	}
+
+	versionFilter.ExcludePrerelease = !prerelease
+}
+
+// getFilteredSections returns sections of versions selected by the version filter from the newest to the oldest
+func getFilteredSections(cl *changelog.Changelog) []changelog.VersionChanges {
+	versions := cl.GetFilteredVersions(versionFilter)
+
+	sections := make([]changelog.VersionChanges, 0, len(versions))
+	for _, ver := range versions {
+		sections = append(sections, cl.Versions[ver.GetVersion()])
+	}
+
+	return sections
+}
+
+// getTargetVersion returns the version passed by 'version' param resolving "latest" and "unreleased" (in any case)
+func getTargetVersion(cl *changelog.Changelog) changelog.Version {
+	switch {
+	case targetVersion.IsLatest():
+		return cl.GetLatestVersion()
+	case targetVersion.IsUnrealized():
+		return changelog.Unreleased
+	default:
+		return targetVersion
+	}
+}
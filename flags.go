@@ -0,0 +1,50 @@
+package main
+
+import (
+	"flag"
+	"strings"
+)
+
+// stringsFlag is a flag which can be passed several times. Passed values replace the default ones
+type stringsFlag struct {
+	values []string
+	isSet  bool
+}
+
+func newStringsFlag(defaults []string) *stringsFlag {
+	return &stringsFlag{values: defaults}
+}
+
+func (f *stringsFlag) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return strings.Join(f.values, ", ")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	if !f.isSet {
+		f.values = nil
+		f.isSet = true
+	}
+
+	f.values = append(f.values, value)
+
+	return nil
+}
+
+func (f *stringsFlag) Values() []string {
+	return f.values
+}
+
+func isFlagPassed(name string) bool {
+	passed := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			passed = true
+		}
+	})
+
+	return passed
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+// fmtCommand prints the formatted changelog. References are linkified only if -linkify is passed
+// and headings of kinds are replaced by canonical names only if -normalize is passed
+func fmtCommand(cl *changelog.Changelog) {
+	if normalize {
+		cl.NormalizeHeadings()
+	}
+
+	var opts changelog.RenderOptions
+	if linkify {
+		opts.LinkTemplates = renderOptions.LinkTemplates
+	}
+
+	fmt.Println(cl.Render(opts))
+}
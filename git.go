@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	fpath "path/filepath"
+	"strings"
+)
+
+// gitRun executes git with passed arguments and returns its STDOUT
+func gitRun(args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command("git", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.Bytes(), nil
+}
+
+// gitShowFile returns content of the file at the revision. Relative paths are resolved from the current directory
+func gitShowFile(revision, path string) ([]byte, error) {
+	if !fpath.IsAbs(path) {
+		return gitRun("show", fmt.Sprintf("%s:./%s", revision, fpath.ToSlash(fpath.Clean(path))))
+	}
+
+	out, err := gitRun("rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, err
+	}
+
+	rel, err := fpath.Rel(strings.TrimSpace(string(out)), path)
+	if err != nil {
+		return nil, err
+	}
+
+	return gitRun("show", fmt.Sprintf("%s:%s", revision, fpath.ToSlash(rel)))
+}
+
+// gitCurrentBranch returns name of the checked out branch or "HEAD" for detached state
+func gitCurrentBranch() (string, error) {
+	out, err := gitRun("rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+// gitTagExists reports whether one of the tags exists in the repository
+func gitTagExists(tags ...string) (bool, error) {
+	for _, tag := range tags {
+		out, err := gitRun("tag", "--list", tag)
+		if err != nil {
+			return false, err
+		}
+
+		if strings.TrimSpace(string(out)) != "" {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
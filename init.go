@@ -1,8 +1,6 @@
 package main
 
 import (
-	"fmt"
-
 	"github.com/s-larionov/changelog-cli/pkg/changelog"
 )
 
@@ -24,5 +22,5 @@ func initCommand() {
 	changes.Set(changelog.Added, clDefaultAddChangelogChanges)
 	_ = cl.Add(changelog.Unreleased, changes)
 
-	fmt.Println(cl.ToMarkdown())
+	printChangelog(cl)
 }
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+type listItem struct {
+	Version  changelog.VersionString   `json:"version"`
+	Date     *time.Time                `json:"date,omitempty"`
+	Majority changelog.ChangesMajority `json:"majority"`
+	Yanked   bool                      `json:"yanked"`
+}
+
+func listCommand(cl *changelog.Changelog) {
+	versions := cl.GetFilteredVersions(versionFilter)
+
+	items := make([]listItem, 0, len(versions))
+	for _, ver := range versions {
+		changes, _ := cl.GetChanges(ver)
+
+		item := listItem{
+			Version:  ver.GetVersion(),
+			Majority: changes.GetMajority(breakingMarkers.Values()...),
+			Yanked:   ver.IsYanked(),
+		}
+		if date := ver.GetDate(); !date.IsZero() {
+			item.Date = &date
+		}
+
+		items = append(items, item)
+	}
+
+	if sortOrder == SortAsc {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+
+	if format == FormatJSON {
+		printJSON(items)
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, item := range items {
+		date := "-"
+		if item.Date != nil {
+			date = item.Date.Format(dateLayout)
+		}
+
+		yanked := ""
+		if item.Yanked {
+			yanked = "[YANKED]"
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", item.Version, date, item.Majority, yanked)
+	}
+	_ = w.Flush()
+}
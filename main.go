@@ -3,34 +3,124 @@ package main
 import (
 	"flag"
 	"fmt"
-	"io"
 	"os"
 	"strings"
+	"time"
 
-	"github.com/s-larionov/changelog-cli/pkg"
 	"github.com/s-larionov/changelog-cli/pkg/changelog"
+	"github.com/s-larionov/changelog-cli/pkg/convert"
+	"github.com/s-larionov/changelog-cli/pkg/debian"
+	"github.com/s-larionov/changelog-cli/pkg/notify"
+	"github.com/s-larionov/changelog-cli/pkg/release"
+	"github.com/s-larionov/changelog-cli/pkg/server"
+	"github.com/s-larionov/changelog-cli/pkg/versionfiles"
 )
 
 const (
-	InitCommand          Command = "init"
-	DiffCommand          Command = "diff"
-	BumpCommand          Command = "bump"
-	LatestVersionCommand Command = "latest_version"
-	GetDirectionCommand  Command = "direction"
+	InitCommand              Command = "init"
+	DiffCommand              Command = "diff"
+	BumpCommand              Command = "bump"
+	LatestVersionCommand     Command = "latest_version"
+	GetDirectionCommand      Command = "direction"
+	CheckPRCommand           Command = "check-pr"
+	CompareCommand           Command = "compare"
+	MergeDriverCommand       Command = "merge-driver"
+	StatsCommand             Command = "stats"
+	SearchCommand            Command = "search"
+	ShowCommand              Command = "show"
+	ListCommand              Command = "list"
+	UnreleaseCommand         Command = "unrelease"
+	RenameCommand            Command = "rename"
+	RedateCommand            Command = "redate"
+	MoveEntryCommand         Command = "move-entry"
+	DeleteEntryCommand       Command = "delete-entry"
+	DeprecationsCommand      Command = "deprecations"
+	RenderCommand            Command = "render"
+	RefsCommand              Command = "refs"
+	FmtCommand               Command = "fmt"
+	ConvertCommand           Command = "convert"
+	DebianCommand            Command = "debian"
+	RPMCommand               Command = "rpm"
+	ReleasePayloadCommand    Command = "release-payload"
+	NotifyCommand            Command = "notify"
+	ServeCommand             Command = "serve"
+	CheckVersionFilesCommand Command = "check-version-files"
 
 	UseSTDIN = "stdin"
+
+	dateLayout = "2006-01-02"
 )
 
 type Command string
 
+// formatCommands are commands supporting -format parameter
+var formatCommands = map[Command]bool{
+	StatsCommand:        true,
+	SearchCommand:       true,
+	ListCommand:         true,
+	ShowCommand:         true,
+	RefsCommand:         true,
+	DeprecationsCommand: true,
+}
+
 var (
 	command              Command
 	filepath             string
 	manualVersion        changelog.Version
+	targetVersion        changelog.Version
 	bump                 BumpKind
 	fromString, toString string
 	from, to             changelog.Version
 	failOnEmpty          bool
+	base                 string
+	branch               string
+	releaseBranch        string
+	other                string
+	format               string
+	period               string
+	sinceString          string
+	untilString          string
+	rangeString          string
+	versionFilter        changelog.VersionFilter
+	useVersionFilter     bool
+	query                string
+	kind                 string
+	constraint           string
+	prerelease           bool
+	sortOrder            string
+	force                bool
+	newVersionString     string
+	newVersion           changelog.Version
+	dateString           string
+	date                 time.Time
+	entryText            string
+	toVersionString      string
+	toVersion            changelog.Version
+	toKind               string
+	linkify              bool
+	normalize            bool
+	fromFormat           convert.Format
+	debianOptions        debian.Options
+	urgencies            = newStringsFlag(nil)
+	maintainer           string
+	revision             string
+	specPath             string
+	repositoryURL        string
+	tagPrefix            string
+	provider             release.Provider
+	draft                bool
+	publish              bool
+	apiURL               string
+	repository           string
+	notifyTarget         notify.Target
+	webhook              string
+	notifyTitle          string
+	addr                 string
+	serveChangelogs      = newStringsFlag(nil)
+	serveSources         []server.Source
+	reloadInterval       time.Duration
+	versionFileSpecs     = newStringsFlag(nil)
+	versionFiles         []versionfiles.VersionFile
 )
 
 func init() {
@@ -38,18 +128,90 @@ func init() {
 		Usage("")
 	}
 
+	registerChangelogFlags()
+
 	flag.StringVar(&filepath, "file", "CHANGELOG.md", "Path to the source of the changelog in markdown format or 'STDIN' for reading content from STDIN")
 	flag.StringVar(&fromString, "from", "latest", "From which version should we generate diff?")
 	flag.StringVar(&toString, "to", "Unreleased", "Until which version should we generate diff?")
 	flag.BoolVar(&failOnEmpty, "fail-on-empty", false, "If this param is passed the tool will return non-zero exit code on 'no changes'")
-	commandStr := flag.String("command", "diff", "Command for execution (diff, bump, latest_version, direction, init)")
+	flag.StringVar(&base, "base", "", "Git revision to compare the changelog with in check-pr command (e.g. origin/main)")
+	flag.StringVar(&branch, "branch", "", "Name of the current branch for check-pr command. Detected by git if it's empty")
+	flag.StringVar(&releaseBranch, "release-branch", "^release/", "Regular expression for names of branches where new versions are allowed")
+	flag.StringVar(&other, "other", "", "Path to the changelog to compare with in compare command or 'STDIN' for reading content from STDIN")
+	flag.StringVar(&format, "format", FormatText, "Output format in stats, search, list, show, refs and deprecations commands (text, json; markdown is supported and used by default in show command)")
+	flag.StringVar(&period, "period", string(changelog.PeriodQuarter), "Period for counting releases in stats command (month, quarter, year)")
+	flag.StringVar(&sinceString, "since", "", "Select only versions released since the date (YYYY-MM-DD)")
+	flag.StringVar(&untilString, "until", "", "Select only versions released until the date (YYYY-MM-DD)")
+	flag.StringVar(&query, "query", "", "Regular expression for searching entries in search command")
+	flag.StringVar(&kind, "kind", "", "Kind of changes (Added, Fixed, etc.) for filtering entries in search command or kind of the entry for editing")
+	flag.StringVar(&rangeString, "range", "", "Range of versions (e.g. \"^1.4\", \"1.2.0..HEAD\"). Replaces 'from' and 'to' params")
+	flag.StringVar(&constraint, "constraint", "", "Semver constraint for selecting versions (e.g. \">=1.2, <2.0.0\")")
+	flag.BoolVar(&prerelease, "prerelease", true, "Include pre-release versions (e.g. 1.0.0-beta.1)")
+	flag.StringVar(&sortOrder, "sort", SortDesc, "Sort order of versions in list command (asc, desc)")
+	flag.BoolVar(&force, "force", false, "Unrelease the version even if it's tagged in git")
+	flag.StringVar(&newVersionString, "new-version", "", "New number of the version for rename command")
+	flag.StringVar(&dateString, "date", "", "New date of the version for redate command (YYYY-MM-DD)")
+	flag.StringVar(&entryText, "entry", "", "Text of the entry for move-entry and delete-entry commands")
+	flag.StringVar(&toVersionString, "to-version", "", "Target version for move-entry command (the same version by default)")
+	flag.StringVar(&toKind, "to-kind", "", "Target kind of changes for move-entry command (the same kind by default)")
+	flag.BoolVar(&linkify, "linkify", false, "Turn references into links in the output of fmt command")
+	flag.BoolVar(&normalize, "normalize", false, "Replace headings of kinds by canonical names in fmt command")
+	flag.StringVar(&debianOptions.Package, "package", "", "Name of the package in debian command")
+	flag.StringVar(&debianOptions.Distribution, "distribution", "unstable", "Target distribution in debian command")
+	flag.StringVar(&revision, "revision", "1", "Revision (release) of the package in debian and rpm commands")
+	flag.StringVar(&maintainer, "maintainer", "", "Maintainer (packager) in format 'Name <email>' in debian and rpm commands (DEBFULLNAME and DEBEMAIL env by default in debian command)")
+	flag.StringVar(&specPath, "spec", "", "Path to the RPM spec file which %changelog section should be replaced in rpm command")
+	flag.Var(urgencies, "urgency", "Urgency of the kind of changes in format 'Kind=urgency' in debian command (can be passed several times, default Security=high)")
+	fromFormatSrc := flag.String("from-format", "", "Format of the source changelog in convert command (conventional, github-releases)")
+	flag.StringVar(&repositoryURL, "repository-url", "", "URL of the repository for compare links of versions (detected by existing links if it's empty)")
+	flag.StringVar(&tagPrefix, "tag-prefix", "v", "Prefix of git tags of versions for compare links and release-payload command")
+	flag.BoolVar(&draft, "draft", false, "Create the release as a draft in release-payload command (GitHub only)")
+	flag.BoolVar(&publish, "publish", false, "Publish the release by API in release-payload command (the token is read from GITHUB_TOKEN or GITLAB_TOKEN env)")
+	flag.StringVar(&apiURL, "api-url", "", "Base URL of the API in release-payload command (GITHUB_API_URL or CI_API_V4_URL env, public API by default)")
+	flag.StringVar(&repository, "repository", "", "Repository 'owner/repo' for GitHub or ID of the project for GitLab in release-payload command (GITHUB_REPOSITORY or CI_PROJECT_ID env by default)")
+	providerSrc := flag.String("provider", string(release.ProviderGitHub), "Provider of the release API in release-payload command (github, gitlab)")
+	flag.StringVar(&webhook, "webhook", "", "URL of the incoming webhook in notify command (the payload is printed if it's empty)")
+	flag.StringVar(&notifyTitle, "title", "", "Title of the message in notify command (generated by versions by default)")
+	flag.StringVar(&addr, "addr", ":8080", "Address for listening in serve command")
+	flag.Var(serveChangelogs, "changelog", "Changelog in format 'name=path' in serve command (can be passed several times, 'default' named 'file' param by default)")
+	flag.DurationVar(&reloadInterval, "reload-interval", server.DefaultReloadInterval, "Interval of checking changes of changelog files in serve command")
+	flag.Var(versionFileSpecs, "version-file", "File with the version updated on bump and verified by check-version-files command in format 'path:format:key', formats: json, yaml, toml, regex (can be passed several times)")
+	targetSrc := flag.String("target", "", "Chat platform in notify command (slack, mattermost, teams)")
+	commandStr := flag.String("command", "diff", "Command for execution (diff, bump, latest_version, direction, init, check-pr, compare, merge-driver, stats, search, show, list, unrelease, rename, redate, move-entry, delete-entry, deprecations, render, refs, fmt, convert, debian, rpm, release-payload, notify, serve, check-version-files)")
 	bumpSrc := flag.String("bump", "auto", "Specified kind for bumping (patch, minor, major, auto)")
-	versionSrc := flag.String("version", "", "Specified version for bumping. This param will override bump param")
+	versionSrc := flag.String("version", "", "Specified version for bumping (this param will override bump param) or version for show, unrelease, release-payload, notify and editing commands")
 
 	flag.Parse()
 
+	// The command can be passed as the first positional argument: `changelog-cli merge-driver %O %A %B`.
+	// Positional arguments are kept as is if the command is passed by the flag
+	if flag.NArg() > 0 && !isFlagPassed("command") {
+		*commandStr = flag.Arg(0)
+		_ = flag.CommandLine.Parse(flag.Args()[1:])
+	}
+
+	parseChangelogFlags()
+
 	command = Command(strings.ToLower(*commandStr))
-	if command == InitCommand {
+	switch command {
+	case InitCommand:
+		return
+	case ConvertCommand:
+		format, err := convert.NewFormat(*fromFormatSrc)
+		if err != nil {
+			Usage(fmt.Sprintf("Wrong from-format parameter: %v\n", err))
+			os.Exit(1)
+		}
+		fromFormat = format
+		return
+	case MergeDriverCommand:
+		if flag.NArg() != 3 {
+			Usage("Command merge-driver requires exactly 3 arguments: %O %A %B")
+			os.Exit(1)
+		}
+		return
+	case ServeCommand:
+		parseServeSources(serveChangelogs.Values())
 		return
 	}
 
@@ -59,7 +221,7 @@ func init() {
 	}
 
 	switch command {
-	case DiffCommand, GetDirectionCommand:
+	case DiffCommand, GetDirectionCommand, RefsCommand, NotifyCommand:
 		var err error
 		from, err = changelog.NewVersion(changelog.VersionString(fromString), nil)
 		if err != nil {
@@ -72,12 +234,28 @@ func init() {
 			Usage(fmt.Sprintf("Wrong format for 'to' version: %v\n", err))
 			os.Exit(1)
 		}
+
+		// Range expressions and dates replace 'from' and 'to' params in diff and refs commands
+		useVersionFilter = command != GetDirectionCommand && (rangeString != "" || sinceString != "" || untilString != "")
+		if useVersionFilter {
+			if isFlagPassed("from") || isFlagPassed("to") {
+				Usage("Parameters 'range', 'since' and 'until' can't be combined with 'from' and 'to' parameters")
+				os.Exit(1)
+			}
+
+			parseVersionFilter()
+		}
+
+		if command == NotifyCommand {
+			parseNotifyParams(*targetSrc, *versionSrc)
+		}
 	case BumpCommand:
 		if _, ok := availableKinds[BumpKind(strings.ToLower(*bumpSrc))]; !ok {
 			Usage(fmt.Sprintf("Wrong bump parameter: %v\n", *bumpSrc))
 			os.Exit(1)
 		}
 		bump = BumpKind(*bumpSrc)
+		parseVersionFiles(versionFileSpecs.Values())
 
 		if *versionSrc != "" {
 			var err error
@@ -89,35 +267,143 @@ func init() {
 
 			bump = BumpManual
 		}
-	case LatestVersionCommand:
+	case CheckPRCommand:
+		if base == "" {
+			Usage("Parameter 'base' is required for check-pr command")
+			os.Exit(1)
+		}
+
+		if strings.EqualFold(filepath, UseSTDIN) {
+			Usage("Command check-pr does not support reading changelog from STDIN")
+			os.Exit(1)
+		}
+	case CompareCommand:
+		if other == "" {
+			Usage("Parameter 'other' is required for compare command")
+			os.Exit(1)
+		}
+
+		if strings.EqualFold(filepath, UseSTDIN) && strings.EqualFold(other, UseSTDIN) {
+			Usage("Only one of 'file' and 'other' can be read from STDIN")
+			os.Exit(1)
+		}
+	case StatsCommand:
+		parseVersionFilter()
+
+		switch changelog.StatsPeriod(period) {
+		case changelog.PeriodMonth, changelog.PeriodQuarter, changelog.PeriodYear:
+		default:
+			Usage(fmt.Sprintf("Wrong period parameter: %v\n", period))
+			os.Exit(1)
+		}
+	case SearchCommand:
+		if query == "" {
+			Usage("Parameter 'query' is required for search command")
+			os.Exit(1)
+		}
+
+		parseVersionFilter()
+	case ShowCommand, UnreleaseCommand, ReleasePayloadCommand:
+		if *versionSrc == "" {
+			*versionSrc = string(changelog.LatestValue)
+		}
+
+		var err error
+		targetVersion, err = changelog.NewVersion(changelog.VersionString(*versionSrc), nil)
+		if err != nil {
+			Usage(fmt.Sprintf("Wrong format for version: %v\n", err))
+			os.Exit(1)
+		}
+
+		if command == ShowCommand && !isFlagPassed("format") {
+			format = FormatMarkdown
+		}
+
+		if command == ReleasePayloadCommand {
+			provider, err = release.NewProvider(*providerSrc)
+			if err != nil {
+				Usage(fmt.Sprintf("Wrong provider parameter: %v\n", err))
+				os.Exit(1)
+			}
+		}
+	case RenameCommand, RedateCommand, MoveEntryCommand, DeleteEntryCommand:
+		parseEditParams(*versionSrc)
+	case DebianCommand:
+		parseVersionFilter()
+		parseDebianParams(urgencies.Values())
+	case RPMCommand:
+		parseVersionFilter()
+
+		if maintainer == "" {
+			Usage("Parameter 'maintainer' is required for rpm command")
+			os.Exit(1)
+		}
+	case ListCommand:
+		parseVersionFilter()
+
+		if sortOrder != SortAsc && sortOrder != SortDesc {
+			Usage(fmt.Sprintf("Wrong sort parameter: %v\n", sortOrder))
+			os.Exit(1)
+		}
+	case FmtCommand:
+		if linkify && len(linkTemplates.Values()) == 0 {
+			Usage("Parameter 'link-template' is required for linkifying references")
+			os.Exit(1)
+		}
+	case CheckVersionFilesCommand:
+		if len(versionFileSpecs.Values()) == 0 {
+			Usage("Parameter 'version-file' is required for check-version-files command")
+			os.Exit(1)
+		}
+
+		parseVersionFiles(versionFileSpecs.Values())
+	case LatestVersionCommand, DeprecationsCommand, RenderCommand:
 	default:
 		Usage(fmt.Sprintf("Wrong command: %v\n", *commandStr))
 		os.Exit(1)
 	}
+
+	switch {
+	case !formatCommands[command] && isFlagPassed("format"):
+		Usage(fmt.Sprintf("Parameter 'format' is not supported by %s command\n", command))
+		os.Exit(1)
+	case format != FormatText && format != FormatJSON && (format != FormatMarkdown || command != ShowCommand):
+		Usage(fmt.Sprintf("Wrong format parameter: %v\n", format))
+		os.Exit(1)
+	}
 }
 
-func readChangelog(filepath string) ([]byte, error) {
+// readChangelog reads the changelog from the file or from STDIN
+func readChangelog(filepath string) (*changelog.Changelog, error) {
 	if !strings.EqualFold(filepath, UseSTDIN) {
-		return os.ReadFile(filepath)
+		return changelog.Load(filepath, readOptions...)
 	}
 
-	return io.ReadAll(os.Stdin)
+	return changelog.Read(os.Stdin, readOptions...)
 }
 
 func main() {
-	if command == InitCommand {
+	switch command {
+	case InitCommand:
 		initCommand()
 		return
+	case MergeDriverCommand:
+		mergeDriverCommand()
+		return
+	case ConvertCommand:
+		convertCommand()
+		return
+	case ServeCommand:
+		serveCommand()
+		return
 	}
 
-	clContent, err := readChangelog(filepath)
+	cl, err := readChangelog(filepath)
 	if err != nil {
 		Usage(fmt.Sprintf("Unable to read changelog file: %v\n", err))
 		os.Exit(1)
 	}
 
-	cl := pkg.ParseMarkdownFile(clContent)
-
 	switch command {
 	case DiffCommand:
 		diffCommand(cl)
@@ -127,32 +413,39 @@ func main() {
 		latestVersionCommand(cl)
 	case GetDirectionCommand:
 		getDirectionCommand(cl)
+	case CheckPRCommand:
+		checkPRCommand(cl)
+	case CompareCommand:
+		compareCommand(cl)
+	case StatsCommand:
+		statsCommand(cl)
+	case SearchCommand:
+		searchCommand(cl)
+	case ShowCommand:
+		showCommand(cl)
+	case ListCommand:
+		listCommand(cl)
+	case UnreleaseCommand:
+		unreleaseCommand(cl)
+	case RenameCommand, RedateCommand, MoveEntryCommand, DeleteEntryCommand:
+		editCommand(cl)
+	case DeprecationsCommand:
+		deprecationsCommand(cl)
+	case RenderCommand:
+		renderCommand(cl)
+	case RefsCommand:
+		refsCommand(cl)
+	case FmtCommand:
+		fmtCommand(cl)
+	case DebianCommand:
+		debianCommand(cl)
+	case RPMCommand:
+		rpmCommand(cl)
+	case ReleasePayloadCommand:
+		releasePayloadCommand(cl)
+	case NotifyCommand:
+		notifyCommand(cl)
+	case CheckVersionFilesCommand:
+		checkVersionFilesCommand(cl)
 	}
 }
-
-func Usage(msg string) {
-	if msg != "" {
-		fmt.Println(msg)
-		fmt.Println()
-	}
-
-	fmt.Printf("Usage of %s:\n", os.Args[0])
-	fmt.Println("  Show diff between versions:")
-	fmt.Printf("    %s -command=diff [-file=CHANGELOG.md] [-from=latest] [-to=Unreleased]\n", os.Args[0])
-	fmt.Println()
-	fmt.Println("  Bump new version:")
-	fmt.Printf("    %s -command=bump [-file=CHANGELOG.md] [-bump=auto] [-version=]\n", os.Args[0])
-	fmt.Println()
-	fmt.Println("  Init new changelog:")
-	fmt.Printf("    %s -command=init\n", os.Args[0])
-	fmt.Println()
-	fmt.Println("  Show release direction (UPGRADE, ROLLBACK, REDEPLOY):")
-	fmt.Printf("    %s -command=direction [-file=CHANGELOG.md] -from=0.1.4 -to=2.3.4\n", os.Args[0])
-	fmt.Println()
-	fmt.Println("  Get the latest released version from the CHANGELOG:")
-	fmt.Printf("    %s -command=latest_version [-file=CHANGELOG.md]\n", os.Args[0])
-	fmt.Println()
-
-	fmt.Println("Parameters:")
-	flag.PrintDefaults()
-}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+// mergeDriverCommand merges changelogs as git merge driver: `changelog-cli merge-driver %O %A %B`.
+// The result is written to the %A file, non-zero exit code means conflicts: both sides of conflicting
+// versions are written between conflict markers
+func mergeDriverCommand() {
+	changelogs := make([]*changelog.Changelog, 0, 3)
+	for _, path := range flag.Args() {
+		cl, err := changelog.Load(path, readOptions...)
+		if err != nil {
+			Usage(fmt.Sprintf("Unable to read changelog file: %v\n", err))
+			os.Exit(1)
+		}
+
+		changelogs = append(changelogs, cl)
+	}
+
+	merged, conflicts := changelog.Merge(changelogs[0], changelogs[1], changelogs[2])
+
+	if err := merged.Save(flag.Arg(1)); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Unable to write merged changelog: %v\n", err)
+		os.Exit(1)
+	}
+
+	for _, ver := range conflicts {
+		_, _ = fmt.Fprintf(os.Stderr, "[CONFLICT] Version %s was changed differently on both sides\n", ver.GetVersion())
+	}
+
+	if len(conflicts) > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+	"github.com/s-larionov/changelog-cli/pkg/notify"
+)
+
+// notifyCommand posts changes between versions (or changes of the version) to the chat or prints the payload
+func notifyCommand(cl *changelog.Changelog) {
+	var changes changelog.Changes
+	title := notifyTitle
+
+	if isFlagPassed("version") {
+		ver := getTargetVersion(cl)
+
+		section, ok := cl.Versions[ver.GetVersion()]
+		if !ok {
+			Usage(fmt.Sprintf("Version %s does not exist in the changelog", ver.GetVersion()))
+			os.Exit(1)
+		}
+
+		changes = section.Changes
+		if title == "" {
+			title = fmt.Sprintf("Release %s", ver.GetVersion())
+		}
+	} else {
+		filter := getDiffFilter(cl)
+		changes = cl.GetDiffByFilter(filter)
+
+		if title == "" && !useVersionFilter {
+			title = fmt.Sprintf("Changes from %s to %s", filter.From.GetVersion(), filter.To.GetVersion())
+		}
+		if title == "" {
+			title = "Release notes"
+		}
+	}
+
+	payload, err := notify.Payload(notifyTarget, notify.NewMessage(title, changes, renderOptions))
+	if err != nil {
+		if failOnEmpty || !errors.Is(err, notify.ErrEmptyMessage) {
+			_, _ = fmt.Fprintf(os.Stderr, "Unable to build notification: %v\n", err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	if webhook == "" {
+		printJSON(payload)
+		return
+	}
+
+	if err := notify.Send(context.Background(), &http.Client{Timeout: publishTimeout}, webhook, payload); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Unable to send notification: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// parseNotifyParams fills the target and the version of notify command
+func parseNotifyParams(targetSrc, versionSrc string) {
+	var err error
+
+	notifyTarget, err = notify.NewTarget(targetSrc)
+	if err != nil {
+		Usage(fmt.Sprintf("Wrong target parameter: %v\n", err))
+		os.Exit(1)
+	}
+
+	if !isFlagPassed("version") {
+		return
+	}
+
+	if isFlagPassed("from") || isFlagPassed("to") || useVersionFilter {
+		Usage("Parameter 'version' can't be combined with 'from', 'to' and range parameters")
+		os.Exit(1)
+	}
+
+	targetVersion, err = changelog.NewVersion(changelog.VersionString(versionSrc), nil)
+	if err != nil {
+		Usage(fmt.Sprintf("Wrong format for version: %v\n", err))
+		os.Exit(1)
+	}
+}
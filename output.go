@@ -0,0 +1,34 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+const (
+	FormatText     = "text"
+	FormatJSON     = "json"
+	FormatMarkdown = "markdown"
+)
+
+// printJSON prints the value to STDOUT as indented JSON
+func printJSON(v any) {
+	output, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Unable to encode JSON: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println(string(output))
+}
+
+// printChangelog prints the changelog in markdown format to STDOUT
+func printChangelog(cl *changelog.Changelog) {
+	if _, err := cl.WriteTo(os.Stdout); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Unable to write changelog: %v\n", err)
+		os.Exit(1)
+	}
+}
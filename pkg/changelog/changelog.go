@@ -10,12 +10,23 @@ import (
 var (
 	ErrVersionAlreadyExists = errors.New("version is already exist")
 	ErrNothingToRelease     = errors.New("changelog does not contain unreleased changes")
+	ErrVersionNotFound      = errors.New("version does not exist")
+	ErrVersionNotReleased   = errors.New("version is not released")
 )
 
+// Changelog is the parsed changelog. Methods which don't modify the changelog are safe for concurrent use,
+// modifications (Release, Add, editing of versions) require exclusive access
 type Changelog struct {
 	Header      string
 	Description string
 	Versions    map[VersionString]VersionChanges
+	// Links are link reference definitions of the footer (e.g. "[1.0.0]: https://...") by their labels
+	Links map[string]string
+	// conflicts are versions changed differently by both sides of Merge
+	conflicts map[VersionString]mergeConflict
+	// breakingMarkers and refPatterns are passed by WithBreakingMarkers and WithRefPatterns (defaults if nil)
+	breakingMarkers []string
+	refPatterns     []RefPattern
 }
 
 func NewChangelog(header, description string, versions map[VersionString]VersionChanges) *Changelog {
@@ -23,6 +34,7 @@ func NewChangelog(header, description string, versions map[VersionString]Version
 		Header:      header,
 		Description: description,
 		Versions:    versions,
+		Links:       make(map[string]string),
 	}
 }
 
@@ -50,23 +62,75 @@ func (l *Changelog) GetLatestVersion() Version {
 
 func (l *Changelog) Release(ver Version) error {
 	if _, ok := l.Versions[ver.GetVersion()]; ok {
-		return fmt.Errorf("%v: %s", ErrVersionAlreadyExists, ver.GetVersion())
+		return fmt.Errorf("%w: %s", ErrVersionAlreadyExists, ver.GetVersion())
 	}
 
 	changes, ok := l.GetChanges(Unreleased)
-	if !ok || changes.GetMajority() == NoChanges {
+	if !ok || changes.GetMajority(l.breakingMarkers...) == NoChanges {
 		return ErrNothingToRelease
 	}
 
+	released := NewVersionChanges(ver, changes)
+	released.Headings = l.Versions[Unreleased.GetVersion()].Headings
+	released.Order = l.Versions[Unreleased.GetVersion()].Order
+
 	l.Versions[Unreleased.GetVersion()] = NewVersionChanges(Unreleased, NewChanges())
-	l.Versions[ver.GetVersion()] = NewVersionChanges(ver, changes)
+	l.Versions[ver.GetVersion()] = released
+
+	return nil
+}
+
+// Unrelease is the inverse of Release: it moves entries of the released version back into Unreleased
+// (appending them per kind to existing unreleased entries) and removes the version
+func (l *Changelog) Unrelease(ver Version) error {
+	if !ver.IsCommon() {
+		return fmt.Errorf("%w: %s", ErrVersionNotReleased, ver.GetVersion())
+	}
+
+	released, ok := l.GetChanges(ver)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrVersionNotFound, ver.GetVersion())
+	}
+
+	unreleased, ok := l.Versions[Unreleased.GetVersion()]
+	if !ok {
+		unreleased = NewVersionChanges(Unreleased, NewChanges())
+	}
+
+	changes := NewChanges()
+	for _, kind := range unreleased.Changes.GetKinds() {
+		changes.Set(kind, unreleased.Changes.Get(kind))
+	}
+
+	for _, kind := range released.GetKinds() {
+		entries := changes.GetEntries(kind)
+		entries = append(entries, subtractEntries(released.GetEntries(kind), entries)...)
+		changes.SetEntries(kind, entries)
+	}
+
+	for kind, heading := range l.Versions[ver.GetVersion()].Headings {
+		if _, ok := unreleased.Headings[kind]; ok || unreleased.Changes.Has(kind) {
+			continue
+		}
+
+		if unreleased.Headings == nil {
+			unreleased.Headings = make(map[ChangesKind]string)
+		}
+		unreleased.Headings[kind] = heading
+	}
+
+	unreleased.Changes = changes
+	unreleased.Order = append(unreleased.Order, l.Versions[ver.GetVersion()].Order...)
+	l.Versions[Unreleased.GetVersion()] = unreleased
+	delete(l.Versions, ver.GetVersion())
+	delete(l.Links, string(ver.GetVersion()))
 
 	return nil
 }
 
 func (l *Changelog) Add(ver Version, changes Changes) error {
 	if _, ok := l.Versions[ver.GetVersion()]; ok {
-		return fmt.Errorf("%v: %s", ErrVersionAlreadyExists, ver.GetVersion())
+		return fmt.Errorf("%w: %s", ErrVersionAlreadyExists, ver.GetVersion())
 	}
 
 	l.Versions[ver.GetVersion()] = NewVersionChanges(ver, changes)
@@ -87,34 +151,20 @@ func (l *Changelog) GetSortedVersions() []Version {
 	return versions
 }
 
+// GetDiff returns united changes of versions after "from" (exclusive) until "to" (inclusive)
 func (l *Changelog) GetDiff(from, to Version) Changes {
-	diff := NewChanges()
-
 	if from.GreaterThan(to) {
 		from, to = to, from
 	}
 
-	latest := l.GetLatestVersion()
-	if from.IsLatest() {
-		from = latest
-	}
-	if to.IsLatest() {
-		to = latest
-	}
-
-	for _, ver := range l.GetSortedVersions() {
-		if ver.IsLatest() {
-			ver = latest
-		}
-
-		if !ver.GreaterThan(from) {
-			continue
-		}
+	return l.GetDiffByFilter(VersionFilter{From: from, To: to, ExcludeFrom: true})
+}
 
-		if ver.GreaterThan(to) {
-			continue
-		}
+// GetDiffByFilter returns united changes of all versions matching the filter
+func (l *Changelog) GetDiffByFilter(filter VersionFilter) Changes {
+	diff := NewChanges()
 
+	for _, ver := range l.GetFilteredVersions(filter) {
 		changes, _ := l.GetChanges(ver)
 		for kind, details := range changes {
 			union := diff.Get(kind)
@@ -130,19 +180,5 @@ func (l *Changelog) GetDiff(from, to Version) Changes {
 }
 
 func (l *Changelog) ToMarkdown() string {
-	output := l.Header + "\n\n" + l.Description + "\n\n"
-
-	for _, ver := range l.GetSortedVersions() {
-		versionString := fmt.Sprintf("## [%s] - %s", ver.GetVersion(), ver.GetDate().Format("2006-01-02"))
-		if ver.IsUnrealized() {
-			versionString = fmt.Sprintf("## [%s]", ver.GetVersion())
-		}
-
-		output += versionString + "\n\n"
-
-		changes, _ := l.GetChanges(ver)
-		output += changes.ToMarkdown() + "\n\n"
-	}
-
-	return strings.TrimSpace(output)
+	return l.Render(RenderOptions{})
 }
@@ -0,0 +1,44 @@
+package changelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestChangelog_Unrelease(t *testing.T) {
+	convey.Convey("unreleasing the version", t, func() {
+		now := time.Now()
+		cl := NewChangelog("", "", map[VersionString]VersionChanges{})
+
+		changes := NewChanges()
+		changes.SetEntries(Fixed, []string{"fix 1"})
+		_ = cl.Add(RequireVersionFromString("1.0.0", &now), changes)
+
+		changes = NewChanges()
+		changes.SetEntries(Fixed, []string{"fix 2"})
+		changes.SetEntries(Added, []string{"feature"})
+		_ = cl.Add(Unreleased, changes)
+
+		convey.So(cl.Release(cl.GetLatestVersion().BumpMinor()), convey.ShouldBeNil)
+
+		changes = NewChanges()
+		changes.SetEntries(Fixed, []string{"fix 3"})
+		cl.Versions[Unreleased.GetVersion()] = NewVersionChanges(Unreleased, changes)
+
+		convey.Convey("should move entries back to unreleased", func() {
+			convey.So(cl.Unrelease(cl.GetLatestVersion()), convey.ShouldBeNil)
+			convey.So(cl.GetLatestVersion().GetVersion(), convey.ShouldEqual, "1.0.0")
+
+			unreleased, _ := cl.GetChanges(Unreleased)
+			convey.So(unreleased.GetEntries(Fixed), convey.ShouldResemble, []string{"fix 3", "fix 2"})
+			convey.So(unreleased.GetEntries(Added), convey.ShouldResemble, []string{"feature"})
+		})
+
+		convey.Convey("should fail for unknown versions", func() {
+			convey.So(cl.Unrelease(RequireVersionFromString("3.0.0", nil)), convey.ShouldNotBeNil)
+			convey.So(cl.Unrelease(Unreleased), convey.ShouldNotBeNil)
+		})
+	})
+}
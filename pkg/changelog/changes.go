@@ -2,7 +2,9 @@ package changelog
 
 import (
 	"errors"
-	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/yuin/goldmark/ast"
@@ -42,13 +44,41 @@ var MajorityMap = map[ChangesKind]ChangesMajority{
 	Removed:    MajorChanges,
 }
 
+// BreakingMarkers are default prefixes of entries describing breaking changes. Such entries make changes major
+// regardless of their kind. Custom markers are passed by WithBreakingMarkers and RenderOptions.BreakingMarkers
+var BreakingMarkers = []string{"BREAKING:", "**Breaking**"}
+
 var ErrNotIsChangesKind = errors.New("the node is not kind of changes")
 
+// reListMarker matches markers of top-level list items
+var reListMarker = regexp.MustCompile(`^([-*+]|\d+[.)])[ \t]+`)
+
 type ChangesKind string
 
 type ChangesMajority uint
 
+func (m ChangesMajority) String() string {
+	switch m {
+	case PatchChanges:
+		return "patch"
+	case MinorChanges:
+		return "minor"
+	case MajorChanges:
+		return "major"
+	default:
+		return "none"
+	}
+}
+
+func (m ChangesMajority) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
 func NewChangesKindFromNode(src []byte, node ast.Node, requiredLevel int) (ChangesKind, error) {
+	return newChangesKindFromNode(src, node, requiredLevel, defaultKinds)
+}
+
+func newChangesKindFromNode(src []byte, node ast.Node, requiredLevel int, kinds KindResolver) (ChangesKind, error) {
 	h, ok := node.(*ast.Heading)
 	if !ok {
 		return "", ErrNotIsChangesKind
@@ -63,12 +93,19 @@ func NewChangesKindFromNode(src []byte, node ast.Node, requiredLevel int) (Chang
 		return "", ErrNotIsChangesKind
 	}
 
-	return ChangesKind(text), nil
+	return kinds.Resolve(text), nil
 }
 
 type VersionChanges struct {
 	Version Version
-	Changes Changes
+	// Description is the text between the version heading and the first kind of changes
+	Description string
+	Changes     Changes
+	// Headings are original headings of kinds which differ from canonical names (e.g. "Bug Fixes" for Fixed)
+	Headings map[ChangesKind]string
+	// Order is the order of kinds in the source document. Kinds which are not in the list are rendered
+	// after them in order of GetKinds
+	Order []ChangesKind
 }
 
 func NewVersionChanges(ver Version, changes Changes) VersionChanges {
@@ -78,6 +115,11 @@ func NewVersionChanges(ver Version, changes Changes) VersionChanges {
 	}
 }
 
+// ToMarkdown renders the whole section of the version: heading, description and changes
+func (c VersionChanges) ToMarkdown() string {
+	return c.Render(RenderOptions{})
+}
+
 type Changes map[ChangesKind]string
 
 func NewChanges() Changes {
@@ -117,11 +159,238 @@ func (c Changes) Has(kind ChangesKind) bool {
 	return true
 }
 
-func (c Changes) GetMajority() ChangesMajority {
+// GetEntries returns list items of the kind without leading markers ("- ", "* ", "1. "). Continuation lines
+// of items (e.g. nested lists) are kept in the entry as is
+func (c Changes) GetEntries(kind ChangesKind) []string {
+	var entries []string
+
+	_, blocks := splitEntryBlocks(c.Get(kind))
+	for _, block := range blocks {
+		entries = append(entries, block.text)
+	}
+
+	return entries
+}
+
+// entryBlock is a top-level block of changes of the kind: a list item with its continuation lines or another block
+// (e.g. a paragraph). The block takes lines [start, end) of the raw content
+type entryBlock struct {
+	text       string
+	marker     string
+	start, end int
+}
+
+// splitEntryBlocks splits the raw content of the kind into lines and top-level blocks
+func splitEntryBlocks(content string) ([]string, []entryBlock) {
+	var blocks []entryBlock
+
+	lines := strings.Split(content, "\n")
+	blank := true
+	for i, line := range lines {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" {
+			blank = true
+			continue
+		}
+
+		marker := reListMarker.FindString(line)
+		indented := line[0] == ' ' || line[0] == '\t'
+
+		switch {
+		case marker != "" || len(blocks) == 0 || blank && !indented:
+			blocks = append(blocks, entryBlock{text: strings.TrimSpace(line[len(marker):]), marker: marker, start: i, end: i + 1})
+		case blank:
+			blocks[len(blocks)-1].text += "\n\n" + line
+			blocks[len(blocks)-1].end = i + 1
+		default:
+			blocks[len(blocks)-1].text += "\n" + line
+			blocks[len(blocks)-1].end = i + 1
+		}
+
+		blank = false
+	}
+
+	return lines, blocks
+}
+
+// removeEntryBlock removes lines of the i-th block with the narrower gap of blank lines around it,
+// so the rest of the content keeps its structure
+func removeEntryBlock(lines []string, blocks []entryBlock, i int) string {
+	start, end := blocks[i].start, blocks[i].end
+	hasPrev, hasNext := i > 0, i < len(blocks)-1
+
+	switch {
+	case hasPrev && (!hasNext || start-blocks[i-1].end <= blocks[i+1].start-end):
+		start = blocks[i-1].end
+	case hasNext:
+		end = blocks[i+1].start
+	}
+
+	content := strings.Join(append(lines[:start:start], lines[end:]...), "\n")
+	if strings.TrimSpace(content) == "" {
+		return ""
+	}
+
+	return content
+}
+
+// removeEntry removes the first entry matching the predicate keeping the rest of the raw content
+// and returns the text of the entry
+func (c Changes) removeEntry(kind ChangesKind, match func(text string) bool) (string, bool) {
+	lines, blocks := splitEntryBlocks(c.Get(kind))
+	for i, block := range blocks {
+		if match(block.text) {
+			c.Set(kind, removeEntryBlock(lines, blocks, i))
+
+			return block.text, true
+		}
+	}
+
+	return "", false
+}
+
+// appendEntry adds the entry as a list item after the last list item of the kind (or to the end of changes)
+// keeping the rest of the raw content. The marker of the item follows the last item of the list
+func (c Changes) appendEntry(kind ChangesKind, text string) {
+	lines, blocks := splitEntryBlocks(c.Get(kind))
+
+	last := -1
+	for i, block := range blocks {
+		if block.marker != "" {
+			last = i
+		}
+	}
+
+	if last < 0 {
+		content := strings.TrimRight(c.Get(kind), " \t\r\n")
+		if content != "" {
+			content += "\n\n"
+		}
+
+		c.Set(kind, content+"- "+text)
+
+		return
+	}
+
+	item := strings.Split(nextListMarker(blocks[last].marker)+text, "\n")
+	at := blocks[last].end
+
+	result := make([]string, 0, len(lines)+len(item))
+	result = append(append(append(result, lines[:at]...), item...), lines[at:]...)
+	c.Set(kind, strings.Join(result, "\n"))
+}
+
+// nextListMarker returns the marker of the item following the item with the marker (the same bullet or "3. " for "2. ")
+func nextListMarker(marker string) string {
+	marker = strings.TrimRight(marker, " \t")
+	if number, err := strconv.Atoi(marker[:len(marker)-1]); err == nil {
+		return strconv.Itoa(number+1) + marker[len(marker)-1:] + " "
+	}
+
+	return marker + " "
+}
+
+// SetEntries replaces changes of the kind by the list of entries
+func (c Changes) SetEntries(kind ChangesKind, entries []string) {
+	lines := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		lines = append(lines, "- "+entry)
+	}
+
+	c.Set(kind, strings.Join(lines, "\n"))
+}
+
+// HasBreaking reports whether any entry is marked by one of markers (BreakingMarkers if they aren't passed)
+func (c Changes) HasBreaking(markers ...string) bool {
+	for kind := range c {
+		for _, entry := range c.GetEntries(kind) {
+			if _, ok := TrimBreakingMarker(entry, markers...); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// SplitBreaking returns entries marked by one of markers (BreakingMarkers if they aren't passed)
+// without markers and the rest of changes
+func (c Changes) SplitBreaking(markers ...string) ([]string, Changes) {
+	var breaking []string
+	rest := NewChanges()
+
+	for _, kind := range c.GetKinds() {
+		rest.Set(kind, c.Get(kind))
+
+		// Only breaking entries are removed, the rest of the kind is kept as is (e.g. paragraphs and code blocks)
+		for {
+			var trimmed string
+			_, ok := rest.removeEntry(kind, func(text string) bool {
+				var marked bool
+				trimmed, marked = TrimBreakingMarker(text, markers...)
+
+				return marked
+			})
+			if !ok {
+				break
+			}
+
+			breaking = append(breaking, trimmed)
+		}
+	}
+
+	return breaking, rest
+}
+
+// TrimBreakingMarker removes the breaking marker from the entry and reports whether the entry was marked.
+// BreakingMarkers are used if markers aren't passed
+func TrimBreakingMarker(entry string, markers ...string) (string, bool) {
+	if markers == nil {
+		markers = BreakingMarkers
+	}
+
+	for _, marker := range markers {
+		if marker != "" && len(entry) >= len(marker) && strings.EqualFold(entry[:len(marker)], marker) {
+			return strings.TrimSpace(entry[len(marker):]), true
+		}
+	}
+
+	return entry, false
+}
+
+// GetKinds returns all non-empty kinds: known kinds in the order of OrderedKinds, then unknown ones alphabetically
+func (c Changes) GetKinds() []ChangesKind {
+	kinds := make([]ChangesKind, 0, len(c))
+	for _, kind := range OrderedKinds {
+		if c.Has(kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	var unknown []ChangesKind
+	for kind := range c {
+		if _, known := MajorityMap[kind]; !known && c.Has(kind) {
+			unknown = append(unknown, kind)
+		}
+	}
+	sort.Slice(unknown, func(i, j int) bool {
+		return unknown[i] < unknown[j]
+	})
+
+	return append(kinds, unknown...)
+}
+
+// GetMajority returns the majority of changes. Entries marked by one of markers (BreakingMarkers
+// if they aren't passed) make changes major
+func (c Changes) GetMajority(markers ...string) ChangesMajority {
 	if len(c) == 0 {
 		return NoChanges
 	}
 
+	if c.HasBreaking(markers...) {
+		return MajorChanges
+	}
+
 	majority := NoChanges
 	for kind, m := range MajorityMap {
 		if c.Has(kind) && m > majority {
@@ -133,16 +402,5 @@ func (c Changes) GetMajority() ChangesMajority {
 }
 
 func (c Changes) ToMarkdown() string {
-	output := ""
-
-	for _, kind := range OrderedKinds {
-		if !c.Has(kind) {
-			continue
-		}
-
-		output += fmt.Sprintf("### %s\n", kind)
-		output += fmt.Sprintf("%s\n\n", c.Get(kind))
-	}
-
-	return strings.TrimSpace(output)
+	return c.Render(RenderOptions{})
 }
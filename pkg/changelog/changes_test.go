@@ -0,0 +1,67 @@
+package changelog
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestChanges_GetEntries(t *testing.T) {
+	convey.Convey("entries of changes", t, func() {
+		changes := NewChanges()
+		changes.Set(Fixed, "- line 1\n- line 2\n\n")
+		changes.Set("Notes", "some notes")
+
+		convey.So(changes.GetEntries(Fixed), convey.ShouldResemble, []string{"line 1", "line 2"})
+		convey.So(changes.GetEntries(Added), convey.ShouldBeEmpty)
+
+		convey.Convey("should be replaced with list", func() {
+			changes.SetEntries(Added, []string{"line 3"})
+
+			convey.So(changes.Get(Added), convey.ShouldEqual, "- line 3")
+			convey.So(changes.GetKinds(), convey.ShouldResemble, []ChangesKind{Fixed, Added, "Notes"})
+		})
+	})
+}
+
+func TestChanges_Breaking(t *testing.T) {
+	convey.Convey("changes with breaking markers", t, func() {
+		changes := NewChanges()
+		changes.SetEntries(Changed, []string{"BREAKING: renamed parameter", "small change"})
+		changes.SetEntries(Fixed, []string{"**Breaking** fixed semantics"})
+
+		convey.So(changes.GetMajority(), convey.ShouldEqual, MajorChanges)
+
+		convey.Convey("should be grouped on rendering", func() {
+			convey.So(changes.Render(RenderOptions{GroupBreaking: true}), convey.ShouldEqual,
+				"### Breaking changes\n- fixed semantics\n- renamed parameter\n\n### Changed\n- small change")
+			convey.So(changes.ToMarkdown(), convey.ShouldContainSubstring, "- BREAKING: renamed parameter")
+		})
+
+		convey.Convey("should keep blocks which are not breaking entries", func() {
+			changes.Set(Changed, "- small change\n- BREAKING: renamed parameter\n\nSome note paragraph.\n\n```shell\n./run\n```")
+			changes.Set(Added, "- feature\n\nAnother note.")
+
+			breaking, rest := changes.SplitBreaking()
+			convey.So(breaking, convey.ShouldResemble, []string{"fixed semantics", "renamed parameter"})
+			convey.So(rest.Get(Added), convey.ShouldEqual, "- feature\n\nAnother note.")
+			convey.So(rest.Get(Changed), convey.ShouldEqual, "- small change\n\nSome note paragraph.\n\n```shell\n./run\n```")
+			convey.So(rest.Has(Fixed), convey.ShouldBeFalse)
+		})
+
+		convey.Convey("should use custom markers", func() {
+			changes.SetEntries(Fixed, []string{"[!] fixed semantics"})
+
+			convey.So(changes.GetMajority("[!]"), convey.ShouldEqual, MajorChanges)
+			convey.So(changes.Render(RenderOptions{GroupBreaking: true, BreakingMarkers: []string{"[!]"}}), convey.ShouldEqual,
+				"### Breaking changes\n- fixed semantics\n\n### Changed\n- BREAKING: renamed parameter\n- small change")
+		})
+
+		convey.Convey("should be minor without markers", func() {
+			changes.SetEntries(Changed, []string{"small change"})
+			changes.SetEntries(Fixed, nil)
+
+			convey.So(changes.GetMajority(), convey.ShouldEqual, MinorChanges)
+		})
+	})
+}
@@ -0,0 +1,61 @@
+package changelog
+
+import "fmt"
+
+// CheckPR returns problems of changes of the changelog in a pull request from base to head. Released versions
+// can be added on release branches only and must not be changed or removed, otherwise Unreleased section must
+// contain new entries
+func CheckPR(base, head *Changelog, isReleaseBranch bool) []string {
+	comparison := Compare(base, head)
+
+	var problems []string
+	released := false
+
+	for _, ver := range comparison.AddedVersions {
+		if ver.IsUnrealized() {
+			continue
+		}
+
+		released = true
+		if !isReleaseBranch {
+			problems = append(problems, fmt.Sprintf("Version %s was added outside a release branch", ver.GetVersion()))
+		}
+	}
+
+	for _, ver := range comparison.RemovedVersions {
+		if !ver.IsUnrealized() {
+			problems = append(problems, fmt.Sprintf("Released version %s was removed", ver.GetVersion()))
+		}
+	}
+
+	for _, changed := range comparison.ChangedVersions {
+		if changed.Version.IsUnrealized() {
+			continue
+		}
+
+		for _, line := range changed.Describe() {
+			problems = append(problems, fmt.Sprintf("Released version %s: %s", changed.Version.GetVersion(), line))
+		}
+	}
+
+	if !released && !hasNewUnreleasedEntries(comparison, head) {
+		problems = append(problems, "Section Unreleased does not contain new entries")
+	}
+
+	return problems
+}
+
+func hasNewUnreleasedEntries(comparison Comparison, head *Changelog) bool {
+	if changed, ok := comparison.GetVersion(Unreleased); ok {
+		return len(changed.Added) > 0
+	}
+
+	for _, ver := range comparison.AddedVersions {
+		if ver.IsUnrealized() {
+			unreleased, _ := head.GetChanges(Unreleased)
+			return len(unreleased.GetKinds()) > 0
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,80 @@
+package changelog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestCheckPR(t *testing.T) {
+	source := `# Changelog
+
+## [Unreleased]
+
+### Fixed
+- Fixed parsing of dates
+
+## [1.0.0] - 2024-01-29
+
+### Added
+- New feature
+`
+
+	parse := func(content string) *Changelog {
+		cl, err := Parse([]byte(content))
+		convey.So(err, convey.ShouldBeNil)
+
+		return cl
+	}
+
+	convey.Convey("checking changes of the changelog in a pull request", t, func() {
+		base := parse(source)
+
+		convey.Convey("should accept new unreleased entries", func() {
+			head := parse(strings.Replace(source, "- Fixed parsing of dates", "- Fixed parsing of dates\n- Fixed typo", 1))
+			convey.So(CheckPR(base, head, false), convey.ShouldBeEmpty)
+		})
+
+		convey.Convey("should accept the new section Unreleased with entries", func() {
+			base := parse(strings.Replace(source, "## [Unreleased]\n\n### Fixed\n- Fixed parsing of dates\n\n", "", 1))
+			convey.So(CheckPR(base, parse(source), false), convey.ShouldBeEmpty)
+		})
+
+		convey.Convey("should require new unreleased entries", func() {
+			head := parse(strings.Replace(source, "- Fixed parsing of dates", "- Fixed parsing of the dates", 1))
+			convey.So(CheckPR(base, head, false), convey.ShouldResemble, []string{"Section Unreleased does not contain new entries"})
+			convey.So(CheckPR(base, base, true), convey.ShouldResemble, []string{"Section Unreleased does not contain new entries"})
+		})
+
+		convey.Convey("should accept a release on release branches only", func() {
+			head := parse(strings.Replace(source, "## [Unreleased]\n", "## [Unreleased]\n\n## [1.1.0] - 2024-02-01\n", 1))
+
+			convey.So(CheckPR(base, head, true), convey.ShouldBeEmpty)
+			convey.So(CheckPR(base, head, false), convey.ShouldResemble, []string{"Version 1.1.0 was added outside a release branch"})
+		})
+
+		convey.Convey("should reject changes of released versions", func() {
+			head := parse(strings.NewReplacer(
+				"- Fixed parsing of dates", "- Fixed parsing of dates\n- Fixed typo",
+				"2024-01-29", "2024-01-30",
+				"- New feature", "- Another feature",
+			).Replace(source))
+
+			convey.So(CheckPR(base, head, true), convey.ShouldResemble, []string{
+				"Released version 1.0.0: date was changed from 2024-01-29 to 2024-01-30",
+				"Released version 1.0.0: entry was removed from Added: New feature",
+				"Released version 1.0.0: entry was added to Added: Another feature",
+			})
+		})
+
+		convey.Convey("should reject removal of released versions", func() {
+			head := parse(strings.NewReplacer(
+				"- Fixed parsing of dates", "- Fixed parsing of dates\n- Fixed typo",
+				"## [1.0.0] - 2024-01-29\n\n### Added\n- New feature\n", "",
+			).Replace(source))
+
+			convey.So(CheckPR(base, head, false), convey.ShouldResemble, []string{"Released version 1.0.0 was removed"})
+		})
+	})
+}
@@ -0,0 +1,215 @@
+package changelog
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// editSimilarity is the minimal share of common words for treating removed and added entries as an edit
+const editSimilarity = 0.5
+
+// Comparison is an entry-level difference between two changelogs
+type Comparison struct {
+	AddedVersions   []Version
+	RemovedVersions []Version
+	ChangedVersions []VersionComparison
+}
+
+// VersionComparison is a difference of the version existing in both changelogs
+type VersionComparison struct {
+	Version Version
+	OldDate time.Time
+	NewDate time.Time
+	Added   []EntryChange
+	Removed []EntryChange
+	Edited  []EntryChange
+}
+
+// EntryChange describes a single entry change. Old is empty for added entries and New is empty for removed ones
+type EntryChange struct {
+	Kind ChangesKind
+	Old  string
+	New  string
+}
+
+// IsEmpty reports whether changelogs are semantically equal
+func (c Comparison) IsEmpty() bool {
+	return len(c.AddedVersions) == 0 && len(c.RemovedVersions) == 0 && len(c.ChangedVersions) == 0
+}
+
+// GetVersion returns comparison of the version if it was changed
+func (c Comparison) GetVersion(ver Version) (VersionComparison, bool) {
+	for _, changed := range c.ChangedVersions {
+		if changed.Version.Equal(ver) {
+			return changed, true
+		}
+	}
+
+	return VersionComparison{}, false
+}
+
+// IsDateChanged reports whether the date of the version was changed
+func (c VersionComparison) IsDateChanged() bool {
+	return !c.OldDate.Equal(c.NewDate)
+}
+
+// IsEmpty reports whether the version was not changed
+func (c VersionComparison) IsEmpty() bool {
+	return !c.IsDateChanged() && len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Edited) == 0
+}
+
+// Describe returns human-readable lines describing changes of the version
+func (c VersionComparison) Describe() []string {
+	var lines []string
+
+	if c.IsDateChanged() {
+		lines = append(lines, fmt.Sprintf("date was changed from %s to %s", formatComparedDate(c.OldDate), formatComparedDate(c.NewDate)))
+	}
+
+	for _, entry := range c.Removed {
+		lines = append(lines, fmt.Sprintf("entry was removed from %s: %s", entry.Kind, entry.Old))
+	}
+
+	for _, entry := range c.Added {
+		lines = append(lines, fmt.Sprintf("entry was added to %s: %s", entry.Kind, entry.New))
+	}
+
+	for _, entry := range c.Edited {
+		lines = append(lines, fmt.Sprintf("entry was edited in %s: %s => %s", entry.Kind, entry.Old, entry.New))
+	}
+
+	return lines
+}
+
+func formatComparedDate(date time.Time) string {
+	if date.IsZero() {
+		return "none"
+	}
+
+	return date.Format("2006-01-02")
+}
+
+// Compare returns the difference between changelogs before and after changes based on parsed versions and entries,
+// so formatting of the documents doesn't matter
+func Compare(before, after *Changelog) Comparison {
+	var comparison Comparison
+
+	for _, ver := range after.GetSortedVersions() {
+		if _, exists := before.Versions[ver.GetVersion()]; !exists {
+			comparison.AddedVersions = append(comparison.AddedVersions, ver)
+		}
+	}
+
+	for _, oldVer := range before.GetSortedVersions() {
+		newVersion, exists := after.Versions[oldVer.GetVersion()]
+		if !exists {
+			comparison.RemovedVersions = append(comparison.RemovedVersions, oldVer)
+			continue
+		}
+
+		oldChanges, _ := before.GetChanges(oldVer)
+		versionComparison := compareChanges(oldChanges, newVersion.Changes)
+		versionComparison.Version = newVersion.Version
+		versionComparison.OldDate = oldVer.GetDate()
+		versionComparison.NewDate = newVersion.Version.GetDate()
+
+		if !versionComparison.IsEmpty() {
+			comparison.ChangedVersions = append(comparison.ChangedVersions, versionComparison)
+		}
+	}
+
+	return comparison
+}
+
+func compareChanges(before, after Changes) VersionComparison {
+	var comparison VersionComparison
+
+	kinds := after.GetKinds()
+	for _, kind := range before.GetKinds() {
+		if !after.Has(kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	for _, kind := range kinds {
+		removed := subtractEntries(before.GetEntries(kind), after.GetEntries(kind))
+		added := subtractEntries(after.GetEntries(kind), before.GetEntries(kind))
+
+		for _, oldEntry := range removed {
+			best, bestSimilarity := -1, editSimilarity
+			for i, newEntry := range added {
+				if similarity := entriesSimilarity(oldEntry, newEntry); similarity >= bestSimilarity {
+					best, bestSimilarity = i, similarity
+				}
+			}
+
+			if best < 0 {
+				comparison.Removed = append(comparison.Removed, EntryChange{Kind: kind, Old: oldEntry})
+				continue
+			}
+
+			comparison.Edited = append(comparison.Edited, EntryChange{Kind: kind, Old: oldEntry, New: added[best]})
+			added = append(added[:best], added[best+1:]...)
+		}
+
+		for _, newEntry := range added {
+			comparison.Added = append(comparison.Added, EntryChange{Kind: kind, New: newEntry})
+		}
+	}
+
+	return comparison
+}
+
+// subtractEntries returns entries which are absent in the other list (with respect to duplicates)
+func subtractEntries(entries, other []string) []string {
+	counts := make(map[string]int)
+	for _, entry := range other {
+		counts[normalizeEntry(entry)]++
+	}
+
+	var result []string
+	for _, entry := range entries {
+		key := normalizeEntry(entry)
+		if counts[key] > 0 {
+			counts[key]--
+			continue
+		}
+
+		result = append(result, entry)
+	}
+
+	return result
+}
+
+// entriesSimilarity returns share of common words of two entries
+func entriesSimilarity(a, b string) float64 {
+	words := make(map[string]bool)
+	for _, word := range strings.Fields(strings.ToLower(a)) {
+		words[word] = false
+	}
+
+	union := len(words)
+	common := 0
+	for _, word := range strings.Fields(strings.ToLower(b)) {
+		seen, ok := words[word]
+		switch {
+		case !ok:
+			union++
+			words[word] = true
+		case !seen:
+			common++
+			words[word] = true
+		}
+	}
+
+	if union == 0 {
+		return 0
+	}
+
+	return float64(common) / float64(union)
+}
+
+func normalizeEntry(entry string) string {
+	return strings.Join(strings.Fields(entry), " ")
+}
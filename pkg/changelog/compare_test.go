@@ -0,0 +1,51 @@
+package changelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestCompare(t *testing.T) {
+	date := time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC)
+	newDate := date.Add(day)
+
+	before := NewChangelog("", "", map[VersionString]VersionChanges{})
+	beforeChanges := NewChanges()
+	beforeChanges.SetEntries(Fixed, []string{"Fixed parsing of dates", "Fixed typo"})
+	_ = before.Add(RequireVersionFromString("1.0.0", &date), beforeChanges)
+	_ = before.Add(RequireVersionFromString("0.9.0", &date), NewChanges())
+
+	after := NewChangelog("", "", map[VersionString]VersionChanges{})
+	afterChanges := NewChanges()
+	afterChanges.SetEntries(Fixed, []string{"Fixed parsing of the dates", "Fixed   typo"})
+	afterChanges.SetEntries(Added, []string{"New feature"})
+	_ = after.Add(RequireVersionFromString("1.0.0", &newDate), afterChanges)
+	_ = after.Add(RequireVersionFromString("1.1.0", &date), NewChanges())
+
+	convey.Convey("comparing changelogs", t, func() {
+		comparison := Compare(before, after)
+
+		convey.So(comparison.IsEmpty(), convey.ShouldBeFalse)
+		convey.So(comparison.AddedVersions, convey.ShouldHaveLength, 1)
+		convey.So(comparison.AddedVersions[0].GetVersion(), convey.ShouldEqual, "1.1.0")
+		convey.So(comparison.RemovedVersions, convey.ShouldHaveLength, 1)
+		convey.So(comparison.RemovedVersions[0].GetVersion(), convey.ShouldEqual, "0.9.0")
+
+		convey.Convey("should detect entry changes ignoring formatting", func() {
+			changed, ok := comparison.GetVersion(RequireVersionFromString("1.0.0", nil))
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(changed.IsDateChanged(), convey.ShouldBeTrue)
+			convey.So(changed.Removed, convey.ShouldBeEmpty)
+			convey.So(changed.Added, convey.ShouldResemble, []EntryChange{{Kind: Added, New: "New feature"}})
+			convey.So(changed.Edited, convey.ShouldResemble, []EntryChange{
+				{Kind: Fixed, Old: "Fixed parsing of dates", New: "Fixed parsing of the dates"},
+			})
+		})
+
+		convey.Convey("should be empty for the same changelog", func() {
+			convey.So(Compare(after, after).IsEmpty(), convey.ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,141 @@
+package changelog
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	reCodeSpan           = regexp.MustCompile("`([^`]+)`")
+	reDeprecatedIn       = regexp.MustCompile(`(?i)deprecated\s+(?:in|since)\s+(?:version\s+)?v?(\S+?)[).,;]*(?:\s|$)`)
+	reDeprecationSubject = regexp.MustCompile(`(?i)^(deprecated?|removed?|dropped|drop)\s+`)
+	reNonWord            = regexp.MustCompile(`[^\p{L}\p{N}\s_.-]+`)
+)
+
+// Deprecation is the Deprecated entry and the Removed entry of a later version linked to it
+type Deprecation struct {
+	Deprecated Entry
+	Removed    *Entry
+}
+
+// DeprecationsReport describes the lifecycle of deprecations in the changelog
+type DeprecationsReport struct {
+	// Removed are deprecations which were removed later
+	Removed []Deprecation
+	// Pending are deprecations which are still pending removal
+	Pending []Deprecation
+	// NotDeprecated are removals which were never deprecated first
+	NotDeprecated []Entry
+}
+
+// GetDays returns how long the entry has been deprecated: until the removal date or until now for pending ones.
+// It returns -1 if the dates are unknown
+func (d Deprecation) GetDays(now time.Time) int {
+	since := d.Deprecated.Version.GetDate()
+	if since.IsZero() {
+		return -1
+	}
+
+	until := now
+	if d.Removed != nil {
+		until = d.Removed.Version.GetDate()
+		if until.IsZero() {
+			until = now
+		}
+	}
+
+	return int(until.Sub(since) / day)
+}
+
+// GetDeprecations links each Deprecated entry to a Removed entry of a later version. The Removed entry is linked
+// if it explicitly references the version ("deprecated in 1.2.0"), mentions the same code span (`identifier`)
+// or has similar text
+func (l *Changelog) GetDeprecations() DeprecationsReport {
+	var report DeprecationsReport
+	var pending []Deprecation
+
+	versions := l.GetSortedVersions()
+	for i := len(versions) - 1; i >= 0; i-- {
+		changes, _ := l.GetChanges(versions[i])
+
+		for _, text := range changes.GetEntries(Removed) {
+			removed := Entry{Version: versions[i], Kind: Removed, Text: text}
+
+			j := findDeprecation(pending, removed)
+			if j < 0 {
+				report.NotDeprecated = append(report.NotDeprecated, removed)
+				continue
+			}
+
+			pending[j].Removed = &removed
+			report.Removed = append(report.Removed, pending[j])
+			pending = append(pending[:j], pending[j+1:]...)
+		}
+
+		for _, text := range changes.GetEntries(Deprecated) {
+			pending = append(pending, Deprecation{Deprecated: Entry{Version: versions[i], Kind: Deprecated, Text: text}})
+		}
+	}
+
+	report.Pending = pending
+
+	return report
+}
+
+// findDeprecation returns index of the deprecation matching the removed entry or -1.
+// If the removed entry references the version of deprecation only deprecations of this version are considered
+// and the only one of them is matched even without similar text
+func findDeprecation(pending []Deprecation, removed Entry) int {
+	var referenced *Version
+	if matches := reDeprecatedIn.FindStringSubmatch(removed.Text); matches != nil {
+		if ver, err := NewVersion(VersionString(matches[1]), nil); err == nil {
+			referenced = &ver
+		}
+	}
+
+	var candidates []int
+	for i, deprecation := range pending {
+		if referenced == nil || deprecation.Deprecated.Version.Equal(*referenced) {
+			candidates = append(candidates, i)
+		}
+	}
+
+	best, bestSimilarity := -1, editSimilarity
+	for _, i := range candidates {
+		similarity := deprecationSimilarity(pending[i].Deprecated.Text, removed.Text)
+		if similarity > bestSimilarity || best < 0 && similarity == bestSimilarity {
+			best, bestSimilarity = i, similarity
+		}
+	}
+
+	if best < 0 && referenced != nil && len(candidates) == 1 {
+		return candidates[0]
+	}
+
+	return best
+}
+
+// deprecationSimilarity compares subjects of deprecated and removed entries. Common code spans mean the same subject
+func deprecationSimilarity(deprecated, removed string) float64 {
+	spans := make(map[string]struct{})
+	for _, match := range reCodeSpan.FindAllStringSubmatch(deprecated, -1) {
+		spans[strings.ToLower(match[1])] = struct{}{}
+	}
+
+	for _, match := range reCodeSpan.FindAllStringSubmatch(removed, -1) {
+		if _, ok := spans[strings.ToLower(match[1])]; ok {
+			return 1
+		}
+	}
+
+	return entriesSimilarity(deprecationSubject(deprecated), deprecationSubject(removed))
+}
+
+func deprecationSubject(text string) string {
+	text = reDeprecatedIn.ReplaceAllString(text, " ")
+	text = reNonWord.ReplaceAllString(text, " ")
+	text = reDeprecationSubject.ReplaceAllString(strings.TrimSpace(text), "")
+
+	return text
+}
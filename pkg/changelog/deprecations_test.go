@@ -0,0 +1,39 @@
+package changelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestChangelog_GetDeprecations(t *testing.T) {
+	cl := NewChangelog("", "", map[VersionString]VersionChanges{})
+	add := func(ver VersionString, date string, deprecated, removed []string) {
+		parsed, _ := time.Parse("2006-01-02", date)
+		changes := NewChanges()
+		changes.SetEntries(Deprecated, deprecated)
+		changes.SetEntries(Removed, removed)
+		_ = cl.Add(RequireVersionFromString(ver, &parsed), changes)
+	}
+
+	add("1.0.0", "2024-01-01", []string{"Deprecated `-old-flag` parameter", "Deprecated XML output format", "Deprecated legacy auth"}, nil)
+	add("1.1.0", "2024-01-11", []string{"Deprecated v1 API endpoints"}, []string{"Removed XML output format"})
+	add("2.0.0", "2024-03-01", nil, []string{"Removed parameter `-old-flag`", "Dropped support of Go 1.19", "Removed endpoints (deprecated in 1.1.0)"})
+
+	convey.Convey("deprecations report", t, func() {
+		report := cl.GetDeprecations()
+
+		convey.So(report.Removed, convey.ShouldHaveLength, 3)
+		convey.So(report.Removed[0].Deprecated.Text, convey.ShouldEqual, "Deprecated XML output format")
+		convey.So(report.Removed[0].GetDays(time.Now()), convey.ShouldEqual, 10)
+		convey.So(report.Removed[1].Removed.Text, convey.ShouldEqual, "Removed parameter `-old-flag`")
+		convey.So(report.Removed[2].Deprecated.Text, convey.ShouldEqual, "Deprecated v1 API endpoints")
+
+		convey.So(report.Pending, convey.ShouldHaveLength, 1)
+		convey.So(report.Pending[0].Deprecated.Text, convey.ShouldEqual, "Deprecated legacy auth")
+
+		convey.So(report.NotDeprecated, convey.ShouldHaveLength, 1)
+		convey.So(report.NotDeprecated[0].Text, convey.ShouldEqual, "Dropped support of Go 1.19")
+	})
+}
@@ -0,0 +1,22 @@
+package changelog
+
+const (
+	Upgrade  Direction = "UPGRADE"
+	Rollback Direction = "ROLLBACK"
+	Redeploy Direction = "REDEPLOY"
+)
+
+// Direction is the direction of the deployment between versions
+type Direction string
+
+// GetDirection returns the direction of the deployment from one version to another
+func GetDirection(from, to Version) Direction {
+	switch {
+	case to.GreaterThan(from):
+		return Upgrade
+	case to.LessThen(from):
+		return Rollback
+	default:
+		return Redeploy
+	}
+}
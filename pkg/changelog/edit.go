@@ -0,0 +1,98 @@
+package changelog
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrEntryNotFound = errors.New("entry does not exist")
+
+// Rename changes the version number keeping its date, description and changes.
+// The link reference definition of the version is moved to the new label as is (see UpdateLinks)
+func (l *Changelog) Rename(ver, newVer Version) error {
+	if !ver.IsCommon() || !newVer.IsCommon() {
+		return fmt.Errorf("%w: only released versions can be renamed", ErrVersionNotReleased)
+	}
+
+	section, ok := l.Versions[ver.GetVersion()]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrVersionNotFound, ver.GetVersion())
+	}
+
+	if _, ok := l.Versions[newVer.GetVersion()]; ok {
+		return fmt.Errorf("%w: %s", ErrVersionAlreadyExists, newVer.GetVersion())
+	}
+
+	section.Version = newVer.WithDate(section.Version.GetDate()).WithYanked(section.Version.IsYanked())
+
+	delete(l.Versions, ver.GetVersion())
+	l.Versions[newVer.GetVersion()] = section
+
+	if url, ok := l.GetLink(ver); ok {
+		delete(l.Links, string(ver.GetVersion()))
+		l.Links[string(newVer.GetVersion())] = url
+	}
+
+	return nil
+}
+
+// SetDate sets the release date of the version
+func (l *Changelog) SetDate(ver Version, date time.Time) error {
+	section, ok := l.Versions[ver.GetVersion()]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrVersionNotFound, ver.GetVersion())
+	}
+
+	if !section.Version.IsCommon() {
+		return fmt.Errorf("%w: %s", ErrVersionNotReleased, ver.GetVersion())
+	}
+
+	section.Version = section.Version.WithDate(date)
+	l.Versions[ver.GetVersion()] = section
+
+	return nil
+}
+
+// MoveEntry moves the entry to another version and/or kind of changes.
+// The entry is added after the last list item of the target kind, the rest of both kinds is kept as is
+func (l *Changelog) MoveEntry(entry Entry, ver Version, kind ChangesKind) error {
+	target, ok := l.GetChanges(ver)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrVersionNotFound, ver.GetVersion())
+	}
+
+	text, err := l.removeEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	target.appendEntry(kind, text)
+
+	return nil
+}
+
+// DeleteEntry removes the entry from the version
+func (l *Changelog) DeleteEntry(entry Entry) error {
+	_, err := l.removeEntry(entry)
+
+	return err
+}
+
+// removeEntry removes the first entry with the same text (ignoring whitespaces) and returns its original text.
+// Only lines of the entry are removed, the rest of the kind is kept as is
+func (l *Changelog) removeEntry(entry Entry) (string, error) {
+	changes, ok := l.GetChanges(entry.Version)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrVersionNotFound, entry.Version.GetVersion())
+	}
+
+	text, ok := changes.removeEntry(entry.Kind, func(text string) bool {
+		return normalizeEntry(text) == normalizeEntry(entry.Text)
+	})
+	if ok {
+		return text, nil
+	}
+
+	return "", fmt.Errorf("%w: %s %s: %s", ErrEntryNotFound, entry.Version.GetVersion(), entry.Kind, entry.Text)
+}
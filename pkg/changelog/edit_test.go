@@ -0,0 +1,115 @@
+package changelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestChangelog_Edit(t *testing.T) {
+	date := time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC)
+	v130 := RequireVersionFromString("1.3.0", &date)
+	v200 := RequireVersionFromString("2.0.0", nil)
+
+	convey.Convey("editing the changelog", t, func() {
+		cl := NewChangelog("", "", map[VersionString]VersionChanges{})
+
+		changes := NewChanges()
+		changes.SetEntries(Changed, []string{"Breaking change", "Small change"})
+		_ = cl.Add(v130, changes)
+		_ = cl.Add(Unreleased, NewChanges())
+
+		convey.Convey("should rename the version keeping its date", func() {
+			convey.So(cl.Rename(v130, v200), convey.ShouldBeNil)
+			convey.So(cl.GetLatestVersion().GetVersion(), convey.ShouldEqual, "2.0.0")
+			convey.So(cl.GetLatestVersion().GetDate(), convey.ShouldEqual, date)
+			convey.So(cl.Rename(v130, v200), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("should rename the version with a links footer", func() {
+			source := `# Changelog
+
+## [Unreleased]
+
+## [1.3.0] - 2024-01-29
+
+### Changed
+- Change
+
+## [1.2.0] - 2024-01-01
+
+### Added
+- Feature
+
+[Unreleased]: https://github.com/org/repo/compare/v1.3.0...HEAD
+[1.3.0]: https://github.com/org/repo/compare/v1.2.0...v1.3.0
+[1.2.0]: https://github.com/org/repo/releases/tag/v1.2.0
+`
+			cl, err := Parse([]byte(source))
+			convey.So(err, convey.ShouldBeNil)
+
+			links, ok := cl.DetectCompareLinks()
+			convey.So(ok, convey.ShouldBeTrue)
+
+			v120 := RequireVersionFromString("1.2.0", nil)
+			v121 := RequireVersionFromString("1.2.1", nil)
+			next, ok := cl.GetNextVersion(v120)
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(next.GetVersion(), convey.ShouldEqual, "1.3.0")
+
+			convey.So(cl.Rename(v120, v121), convey.ShouldBeNil)
+			_, ok = cl.GetLink(v120)
+			convey.So(ok, convey.ShouldBeFalse)
+			convey.So(cl.Links["1.2.1"], convey.ShouldEqual, "https://github.com/org/repo/releases/tag/v1.2.0")
+
+			cl.UpdateLinks(links, v121, next, Unreleased)
+			convey.So(cl.Render(RenderOptions{}), convey.ShouldEndWith, `[Unreleased]: https://github.com/org/repo/compare/v1.3.0...HEAD
+[1.3.0]: https://github.com/org/repo/compare/v1.2.1...v1.3.0
+[1.2.1]: https://github.com/org/repo/releases/tag/v1.2.1`)
+		})
+
+		convey.Convey("should change the date", func() {
+			newDate := date.Add(day)
+
+			convey.So(cl.SetDate(v130, newDate), convey.ShouldBeNil)
+			convey.So(cl.GetLatestVersion().GetDate(), convey.ShouldEqual, newDate)
+			convey.So(cl.SetDate(Unreleased, newDate), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("should move entries between kinds and versions", func() {
+			convey.So(cl.MoveEntry(Entry{Version: v130, Kind: Changed, Text: "Breaking  change"}, v130, Removed), convey.ShouldBeNil)
+			convey.So(cl.MoveEntry(Entry{Version: v130, Kind: Changed, Text: "Small change"}, Unreleased, Added), convey.ShouldBeNil)
+
+			changes, _ := cl.GetChanges(v130)
+			convey.So(changes.GetKinds(), convey.ShouldResemble, []ChangesKind{Removed})
+			convey.So(changes.GetEntries(Removed), convey.ShouldResemble, []string{"Breaking change"})
+
+			unreleased, _ := cl.GetChanges(Unreleased)
+			convey.So(unreleased.GetEntries(Added), convey.ShouldResemble, []string{"Small change"})
+		})
+
+		convey.Convey("should delete entries", func() {
+			convey.So(cl.DeleteEntry(Entry{Version: v130, Kind: Changed, Text: "Small change"}), convey.ShouldBeNil)
+			convey.So(cl.DeleteEntry(Entry{Version: v130, Kind: Changed, Text: "Small change"}), convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("should keep the rest of kinds as is", func() {
+			changes := NewChanges()
+			changes.Set(Changed, "- one\n  - nested item\n- two\n\nMigration note paragraph.")
+			changes.Set(Fixed, "* fix\n\n```shell\n./run\n```")
+			_ = cl.Add(v200, changes)
+
+			convey.So(cl.DeleteEntry(Entry{Version: v200, Kind: Changed, Text: "two"}), convey.ShouldBeNil)
+			convey.So(changes.Get(Changed), convey.ShouldEqual, "- one\n  - nested item\n\nMigration note paragraph.")
+
+			convey.So(cl.MoveEntry(Entry{Version: v200, Kind: Changed, Text: "one\n  - nested item"}, v200, Fixed), convey.ShouldBeNil)
+			convey.So(changes.Get(Changed), convey.ShouldEqual, "Migration note paragraph.")
+			convey.So(changes.Get(Fixed), convey.ShouldEqual, "* fix\n* one\n  - nested item\n\n```shell\n./run\n```")
+
+			convey.So(cl.MoveEntry(Entry{Version: v200, Kind: Fixed, Text: "fix"}, v200, Added), convey.ShouldBeNil)
+			convey.So(changes.Get(Fixed), convey.ShouldEqual, "* one\n  - nested item\n\n```shell\n./run\n```")
+			convey.So(changes.Get(Added), convey.ShouldEqual, "- fix")
+		})
+	})
+}
@@ -0,0 +1,25 @@
+package changelog
+
+// Entry is a single entry of the changelog with the version and the kind it belongs to
+type Entry struct {
+	Version Version
+	Kind    ChangesKind
+	Text    string
+}
+
+// GetEntries returns entries of versions matching the filter: from the greatest version to the least
+// and in the order of kinds in each version
+func (l *Changelog) GetEntries(filter VersionFilter) []Entry {
+	var entries []Entry
+
+	for _, ver := range l.GetFilteredVersions(filter) {
+		changes, _ := l.GetChanges(ver)
+		for _, kind := range changes.GetKinds() {
+			for _, text := range changes.GetEntries(kind) {
+				entries = append(entries, Entry{Version: ver, Kind: kind, Text: text})
+			}
+		}
+	}
+
+	return entries
+}
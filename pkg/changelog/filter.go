@@ -0,0 +1,124 @@
+package changelog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver"
+)
+
+// HeadValue is an alias of Unreleased version in range expressions (e.g. 1.2.0..HEAD)
+const HeadValue = "HEAD"
+
+var ErrInvalidRange = errors.New("invalid version range")
+
+// VersionFilter selects versions of the changelog. Bounds are inclusive unless ExcludeFrom or ExcludeTo is set,
+// zero values mean no bound. Versions without date never match date bounds
+type VersionFilter struct {
+	From        Version
+	To          Version
+	ExcludeFrom bool
+	ExcludeTo   bool
+	Since       time.Time
+	Until       time.Time
+	// Constraint is semver constraint (e.g. ">=1.2, <2.0.0"). Only released versions can match it
+	Constraint *semver.Constraints
+	// ExcludePrerelease skips versions with pre-release part (e.g. 1.0.0-beta.1)
+	ExcludePrerelease bool
+}
+
+// Match reports whether the version satisfies the filter. Keyword Latest in bounds should be resolved before
+func (f VersionFilter) Match(ver Version) bool {
+	if f.From.IsValid() && (ver.LessThen(f.From) || f.ExcludeFrom && ver.Equal(f.From)) {
+		return false
+	}
+
+	if f.To.IsValid() && (ver.GreaterThan(f.To) || f.ExcludeTo && ver.Equal(f.To)) {
+		return false
+	}
+
+	if f.Constraint != nil && (!ver.IsCommon() || !f.Constraint.Check(ver.parsedVersion)) {
+		return false
+	}
+
+	if f.ExcludePrerelease && ver.IsPrerelease() {
+		return false
+	}
+
+	date := ver.GetDate()
+	if !f.Since.IsZero() && (date.IsZero() || date.Before(f.Since)) {
+		return false
+	}
+
+	if !f.Until.IsZero() && (date.IsZero() || date.After(f.Until)) {
+		return false
+	}
+
+	return true
+}
+
+// NewVersionFilterFromRange parses range expression. Supported expressions:
+// - "1.2.0..2.0.0" - versions after 1.2.0 (exclusive) until 2.0.0 (inclusive), like git revision ranges
+// - "1.2.0..HEAD", "1.2.0.." - versions after 1.2.0 including Unreleased changes
+// - "..1.2.0" - all versions until 1.2.0 (inclusive)
+// - "^1.4", ">=1.2, <2.0.0" - released versions matching semver constraint
+//
+// Keywords Latest and Unreleased can be used as bounds as well
+func NewVersionFilterFromRange(expr string) (VersionFilter, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return VersionFilter{}, nil
+	}
+
+	from, to, isRange := strings.Cut(expr, "..")
+	if !isRange {
+		constraint, err := semver.NewConstraint(expr)
+		if err != nil {
+			return VersionFilter{}, fmt.Errorf("%w: %v", ErrInvalidRange, err)
+		}
+
+		return VersionFilter{Constraint: constraint}, nil
+	}
+
+	filter := VersionFilter{ExcludeFrom: true}
+
+	if from = strings.TrimSpace(from); from != "" {
+		ver, err := NewVersion(VersionString(from), nil)
+		if err != nil {
+			return VersionFilter{}, fmt.Errorf("%w: %v", ErrInvalidRange, err)
+		}
+		filter.From = ver
+	}
+
+	if to = strings.TrimSpace(to); to != "" && !strings.EqualFold(to, HeadValue) {
+		ver, err := NewVersion(VersionString(to), nil)
+		if err != nil {
+			return VersionFilter{}, fmt.Errorf("%w: %v", ErrInvalidRange, err)
+		}
+		filter.To = ver
+	}
+
+	return filter, nil
+}
+
+// GetFilteredVersions returns versions matching the filter sorted from the greatest to the least
+func (l *Changelog) GetFilteredVersions(filter VersionFilter) []Version {
+	latest := l.GetLatestVersion()
+	if filter.From.IsLatest() {
+		filter.From = latest
+	}
+	if filter.To.IsLatest() {
+		filter.To = latest
+	}
+
+	var versions []Version
+	for _, ver := range l.GetSortedVersions() {
+		if filter.Match(ver) {
+			versions = append(versions, ver)
+		}
+	}
+
+	return versions
+}
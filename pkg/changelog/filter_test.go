@@ -0,0 +1,76 @@
+package changelog
+
+import (
+	"testing"
+
+	"github.com/Masterminds/semver"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestChangelog_GetFilteredVersions(t *testing.T) {
+	cl := NewChangelog("", "", map[VersionString]VersionChanges{})
+	for _, ver := range []VersionString{"Unreleased", "1.0.0", "1.2.0-beta.1", "1.2.0", "1.3.1", "2.0.0"} {
+		_ = cl.Add(RequireVersionFromString(ver, nil), NewChanges())
+	}
+
+	getVersions := func(filter VersionFilter) []VersionString {
+		var versions []VersionString
+		for _, ver := range cl.GetFilteredVersions(filter) {
+			versions = append(versions, ver.GetVersion())
+		}
+
+		return versions
+	}
+
+	convey.Convey("filtering versions", t, func() {
+		convey.So(getVersions(VersionFilter{}), convey.ShouldHaveLength, 6)
+
+		convey.Convey("by inclusive bounds", func() {
+			filter := VersionFilter{From: RequireVersionFromString("1.2.0", nil), To: Latest}
+			convey.So(getVersions(filter), convey.ShouldResemble, []VersionString{"2.0.0", "1.3.1", "1.2.0"})
+		})
+
+		convey.Convey("by semver constraint", func() {
+			constraint, _ := semver.NewConstraint(">=1.2, <2.0.0")
+			convey.So(getVersions(VersionFilter{Constraint: constraint}), convey.ShouldResemble, []VersionString{"1.3.1", "1.2.0"})
+		})
+
+		convey.Convey("without pre-releases", func() {
+			convey.So(getVersions(VersionFilter{ExcludePrerelease: true}), convey.ShouldNotContain, VersionString("1.2.0-beta.1"))
+		})
+	})
+}
+
+func TestNewVersionFilterFromRange(t *testing.T) {
+	cl := NewChangelog("", "", map[VersionString]VersionChanges{})
+	for _, ver := range []VersionString{"Unreleased", "1.2.0", "1.4.0", "1.4.2", "2.0.0"} {
+		_ = cl.Add(RequireVersionFromString(ver, nil), NewChanges())
+	}
+
+	ranges := map[string][]VersionString{
+		"^1.4":           {"1.4.2", "1.4.0"},
+		"1.2.0..HEAD":    {"Unreleased", "2.0.0", "1.4.2", "1.4.0"},
+		"1.4.0..2.0.0":   {"2.0.0", "1.4.2"},
+		"..1.4.0":        {"1.4.0", "1.2.0"},
+		"1.4.2..":        {"Unreleased", "2.0.0"},
+		"latest..latest": nil,
+	}
+
+	for expr, expected := range ranges {
+		convey.Convey(expr, t, func() {
+			filter, err := NewVersionFilterFromRange(expr)
+			convey.So(err, convey.ShouldBeNil)
+
+			var versions []VersionString
+			for _, ver := range cl.GetFilteredVersions(filter) {
+				versions = append(versions, ver.GetVersion())
+			}
+			convey.So(versions, convey.ShouldResemble, expected)
+		})
+	}
+
+	convey.Convey("invalid range", t, func() {
+		_, err := NewVersionFilterFromRange("foo..bar")
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,65 @@
+package changelog
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Load reads the changelog from the file
+func Load(path string, opts ...Option) (*Changelog, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(content, opts...)
+}
+
+// Read reads the changelog from the reader
+func Read(r io.Reader, opts ...Option) (*Changelog, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(content, opts...)
+}
+
+// WriteTo writes the changelog in markdown format to the writer
+func (l *Changelog) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, l.ToMarkdown()+"\n")
+
+	return int64(n), err
+}
+
+// Save writes the changelog to the file. The file is replaced atomically keeping its permissions
+func (l *Changelog) Save(path string) error {
+	mode := os.FileMode(0o644)
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err := l.WriteTo(tmp); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Chmod(tmp.Name(), mode); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,152 @@
+package changelog
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestRead(t *testing.T) {
+	const md = "# Changelog\n\n- stray entry\n\n## [Unreleased]\n### Added\n- feature\n## Older versions\n"
+
+	convey.Convey("reading changelog", t, func() {
+		convey.Convey("should skip content outside of versions by default", func() {
+			cl, err := Read(strings.NewReader(md))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(cl.Header, convey.ShouldEqual, "# Changelog")
+		})
+
+		convey.Convey("should fail with the position in strict mode", func() {
+			_, err := Read(strings.NewReader(md), WithStrict(true))
+
+			var parseErr *ParseError
+			convey.So(errors.As(err, &parseErr), convey.ShouldBeTrue)
+			convey.So(parseErr.Line, convey.ShouldEqual, 3)
+			convey.So(errors.Is(err, ErrUnexpectedContent), convey.ShouldBeTrue)
+		})
+
+		convey.Convey("should report invalid headings of versions in strict mode", func() {
+			_, err := Read(strings.NewReader(md[strings.Index(md, "## [Unreleased]"):]), WithStrict(true))
+
+			var parseErr *ParseError
+			convey.So(errors.As(err, &parseErr), convey.ShouldBeTrue)
+			convey.So(parseErr.Line, convey.ShouldEqual, 4)
+			convey.So(errors.Is(err, ErrInvalidVersionHeading), convey.ShouldBeTrue)
+		})
+	})
+}
+
+func TestChangelog_Save(t *testing.T) {
+	convey.Convey("saving changelog", t, func() {
+		now := time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC)
+		cl := NewChangelog("# Changelog", "", map[VersionString]VersionChanges{})
+
+		changes := NewChanges()
+		changes.SetEntries(Fixed, []string{"fix"})
+		convey.So(cl.Add(RequireVersionFromString("1.0.0", &now), changes), convey.ShouldBeNil)
+
+		convey.Convey("should write markdown", func() {
+			buf := bytes.NewBufferString("")
+			n, err := cl.WriteTo(buf)
+
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(n, convey.ShouldEqual, buf.Len())
+			convey.So(buf.String(), convey.ShouldEqual, "# Changelog\n\n## [1.0.0] - 2024-01-29\n\n### Fixed\n- fix\n")
+		})
+
+		convey.Convey("should be loaded back", func() {
+			path := filepath.Join(t.TempDir(), "CHANGELOG.md")
+			convey.So(cl.Save(path), convey.ShouldBeNil)
+
+			loaded, err := Load(path)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(loaded.ToMarkdown(), convey.ShouldEqual, cl.ToMarkdown())
+		})
+
+		convey.Convey("should wrap typed errors", func() {
+			err := cl.Add(RequireVersionFromString("1.0.0", &now), changes)
+			convey.So(errors.Is(err, ErrVersionAlreadyExists), convey.ShouldBeTrue)
+
+			_, err = Load(filepath.Join(t.TempDir(), "missing.md"))
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	const md = `# Changelog
+
+<!-- markdownlint-disable MD024 -->
+All notable changes to this project will be documented in this file.
+
+## [Unreleased]
+
+### Added
+- item with nested list
+  - nested item
+  - another nested item
+- entry with ` + "`code`" + ` and [link](https://example.com)
+
+### Fixed
+- fix
+
+## [1.0.0] - 2024-01-01
+
+First release.
+
+<!-- comment of the version -->
+
+### Security
+* item with another marker
+
+### Changed
+- multiline
+  entry
+
+  with paragraph
+
+` + "```shell\n## not a version\n```" + `
+
+[Unreleased]: https://github.com/org/repo/compare/v1.0.0...HEAD
+[1.0.0]: https://github.com/org/repo/releases/tag/v1.0.0
+`
+
+	convey.Convey("parsing and writing the changelog", t, func() {
+		cl, err := Parse([]byte(md))
+		convey.So(err, convey.ShouldBeNil)
+
+		convey.Convey("should reproduce the source", func() {
+			buf := bytes.NewBufferString("")
+			_, err := cl.WriteTo(buf)
+
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(buf.String(), convey.ShouldEqual, md)
+		})
+
+		convey.Convey("should keep the order of kinds on editing", func() {
+			convey.So(cl.SetDate(RequireVersionFromString("1.0.0", nil), time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)), convey.ShouldBeNil)
+
+			convey.So(cl.ToMarkdown()+"\n", convey.ShouldEqual, strings.Replace(md, "2024-01-01", "2024-01-02", 1))
+		})
+
+		convey.Convey("should read nested items as parts of entries", func() {
+			unreleased, _ := cl.GetChanges(Unreleased)
+			convey.So(unreleased.GetEntries(Added), convey.ShouldResemble, []string{
+				"item with nested list\n  - nested item\n  - another nested item",
+				"entry with `code` and [link](https://example.com)",
+			})
+
+			released, _ := cl.GetChanges(RequireVersionFromString("1.0.0", nil))
+			convey.So(released.GetEntries(Security), convey.ShouldResemble, []string{"item with another marker"})
+			convey.So(released.GetEntries(Changed), convey.ShouldResemble, []string{
+				"multiline\n  entry\n\n  with paragraph",
+				"```shell\n## not a version\n```",
+			})
+		})
+	})
+}
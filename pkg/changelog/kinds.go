@@ -0,0 +1,132 @@
+package changelog
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// KindAliases are default aliases of kinds of changes: they map headings to canonical kinds. Keys are compared
+// with headings in lower case without emoji (see ResolveKind). Custom aliases are passed by WithKindAliases
+var KindAliases = map[string]ChangesKind{
+	"new features":             Added,
+	"features":                 Added,
+	"feature":                  Added,
+	"добавлено":                Added,
+	"hinzugefügt":              Added,
+	"changes":                  Changed,
+	"improvements":             Changed,
+	"performance improvements": Changed,
+	"изменено":                 Changed,
+	"geändert":                 Changed,
+	"deprecations":             Deprecated,
+	"устарело":                 Deprecated,
+	"veraltet":                 Deprecated,
+	"removals":                 Removed,
+	"удалено":                  Removed,
+	"entfernt":                 Removed,
+	"bug fixes":                Fixed,
+	"bugfixes":                 Fixed,
+	"fixes":                    Fixed,
+	"исправлено":               Fixed,
+	"behoben":                  Fixed,
+	"security fixes":           Security,
+	"безопасность":             Security,
+	"sicherheit":               Security,
+}
+
+// KindNames are localized headings of kinds of changes by locales. Key BreakingChangesTitle is used
+// for the heading of the group of breaking changes
+var KindNames = map[string]map[ChangesKind]string{
+	"en": {},
+	"ru": {
+		Added:                             "Добавлено",
+		Changed:                           "Изменено",
+		Deprecated:                        "Устарело",
+		Removed:                           "Удалено",
+		Fixed:                             "Исправлено",
+		Security:                          "Безопасность",
+		ChangesKind(BreakingChangesTitle): "Критические изменения",
+	},
+	"de": {
+		Added:                             "Hinzugefügt",
+		Changed:                           "Geändert",
+		Deprecated:                        "Veraltet",
+		Removed:                           "Entfernt",
+		Fixed:                             "Behoben",
+		Security:                          "Sicherheit",
+		ChangesKind(BreakingChangesTitle): "Inkompatible Änderungen",
+	},
+}
+
+var reEmojiShortcode = regexp.MustCompile(`:[a-z0-9_+-]+:`)
+
+// defaultKinds resolves headings by canonical kinds and KindAliases
+var defaultKinds = NewKindResolver(KindAliases)
+
+// KindResolver resolves headings of kinds of changes to canonical kinds. Keys are normalized headings
+// (see NewKindResolver)
+type KindResolver map[string]ChangesKind
+
+// NewKindResolver returns the resolver by canonical kinds and aliases. Aliases of the next maps override
+// the previous ones, aliases are compared in lower case without emoji
+func NewKindResolver(aliases ...map[string]ChangesKind) KindResolver {
+	r := make(KindResolver)
+	for _, kind := range OrderedKinds {
+		r[normalizeKindHeading(string(kind))] = kind
+	}
+
+	for _, m := range aliases {
+		for alias, kind := range m {
+			r[normalizeKindHeading(alias)] = kind
+		}
+	}
+
+	return r
+}
+
+// Resolve returns the canonical kind of changes for the heading. Unknown headings are returned as is
+func (r KindResolver) Resolve(heading string) ChangesKind {
+	if kind, ok := r[normalizeKindHeading(heading)]; ok {
+		return kind
+	}
+
+	return ChangesKind(strings.TrimSpace(heading))
+}
+
+// ResolveKind returns the canonical kind of changes for the heading: headings are matched with canonical
+// kinds and KindAliases case-insensitively and without emoji. Unknown headings are returned as is
+func ResolveKind(heading string) ChangesKind {
+	return defaultKinds.Resolve(heading)
+}
+
+// normalizeKindHeading returns the heading in lower case without emoji (including shortcodes like ":bug:")
+func normalizeKindHeading(heading string) string {
+	heading = reEmojiShortcode.ReplaceAllString(heading, "")
+	heading = strings.Map(func(r rune) rune {
+		if unicode.In(r, unicode.So, unicode.Sk, unicode.Cf) || r == '\uFE0F' {
+			return -1
+		}
+
+		return r
+	}, heading)
+
+	return strings.ToLower(strings.Join(strings.Fields(heading), " "))
+}
+
+// GetKindTitle returns the heading of the kind of changes in the locale
+func GetKindTitle(kind ChangesKind, locale string) string {
+	if title, ok := KindNames[locale][kind]; ok {
+		return title
+	}
+
+	return string(kind)
+}
+
+// NormalizeHeadings replaces original headings of kinds of changes by canonical names
+func (l *Changelog) NormalizeHeadings() {
+	for ver, section := range l.Versions {
+		section.Headings = nil
+		l.Versions[ver] = section
+	}
+}
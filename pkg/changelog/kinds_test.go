@@ -0,0 +1,68 @@
+package changelog
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestResolveKind(t *testing.T) {
+	convey.Convey("resolving kinds of changes", t, func() {
+		convey.So(ResolveKind("Fixed"), convey.ShouldEqual, Fixed)
+		convey.So(ResolveKind("added"), convey.ShouldEqual, Added)
+		convey.So(ResolveKind("Bug Fixes"), convey.ShouldEqual, Fixed)
+		convey.So(ResolveKind("🐛 Fixed"), convey.ShouldEqual, Fixed)
+		convey.So(ResolveKind("✨ Features"), convey.ShouldEqual, Added)
+		convey.So(ResolveKind(":sparkles: New Features"), convey.ShouldEqual, Added)
+		convey.So(ResolveKind("Исправлено"), convey.ShouldEqual, Fixed)
+		convey.So(ResolveKind(" Internal "), convey.ShouldEqual, ChangesKind("Internal"))
+
+		convey.Convey("should resolve custom aliases deterministically", func() {
+			kinds := NewKindResolver(KindAliases, map[string]ChangesKind{"Features": Changed, "🔥 Hotfixes": Fixed})
+
+			for i := 0; i < 100; i++ {
+				convey.So(kinds.Resolve("✨ FEATURES"), convey.ShouldEqual, Changed)
+			}
+			convey.So(kinds.Resolve("hotfixes"), convey.ShouldEqual, Fixed)
+			convey.So(ResolveKind("Features"), convey.ShouldEqual, Added)
+		})
+	})
+}
+
+func TestParse_KindHeadings(t *testing.T) {
+	const md = "## [Unreleased]\n### 🐛 Bug Fixes\n- fix\n### Features\n- feature\n### Internal\n- refactoring\n"
+
+	convey.Convey("parsing changelog with aliases of kinds", t, func() {
+		cl, err := Parse([]byte(md))
+		convey.So(err, convey.ShouldBeNil)
+
+		unreleased, _ := cl.GetChanges(Unreleased)
+
+		convey.Convey("should use canonical kinds", func() {
+			convey.So(unreleased.GetEntries(Fixed), convey.ShouldResemble, []string{"fix"})
+			convey.So(unreleased.GetEntries(Added), convey.ShouldResemble, []string{"feature"})
+			convey.So(unreleased.GetMajority(), convey.ShouldEqual, MinorChanges)
+		})
+
+		convey.Convey("should keep original headings", func() {
+			convey.So(cl.ToMarkdown(), convey.ShouldEqual, "## [Unreleased]\n\n### 🐛 Bug Fixes\n- fix\n\n### Features\n- feature\n\n### Internal\n- refactoring")
+		})
+
+		convey.Convey("should normalize headings", func() {
+			cl.NormalizeHeadings()
+			convey.So(cl.ToMarkdown(), convey.ShouldEqual, "## [Unreleased]\n\n### Fixed\n- fix\n\n### Added\n- feature\n\n### Internal\n- refactoring")
+		})
+
+		convey.Convey("should localize headings", func() {
+			unreleased.Set(Removed, "- BREAKING: drop API")
+			convey.So(cl.Render(RenderOptions{Locale: "ru", GroupBreaking: true}), convey.ShouldEqual, "## [Unreleased]\n\n"+
+				"### Критические изменения\n- drop API\n\n### Исправлено\n- fix\n\n### Добавлено\n- feature\n\n### Internal\n- refactoring")
+		})
+
+		convey.Convey("should keep headings on release", func() {
+			ver := RequireVersionFromString("1.0.0", nil)
+			convey.So(cl.Release(ver), convey.ShouldBeNil)
+			convey.So(cl.Versions["1.0.0"].Headings[Fixed], convey.ShouldEqual, "🐛 Bug Fixes")
+		})
+	})
+}
@@ -0,0 +1,142 @@
+package changelog
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// reCompareLink matches compare links in format "https://host/org/repo/compare/v1.0.0...v1.1.0"
+var reCompareLink = regexp.MustCompile(`^(.+)/compare/(.+)\.\.\.(.+)$`)
+
+// CompareLinks describes how links of versions in the footer of the changelog are built
+type CompareLinks struct {
+	// RepositoryURL is the URL of the repository (e.g. https://github.com/org/repo)
+	RepositoryURL string
+	// TagPrefix is the prefix of git tags of versions (e.g. "v")
+	TagPrefix string
+}
+
+// GetTag returns the git revision of the version
+func (c CompareLinks) GetTag(ver Version) string {
+	if ver.IsUnrealized() {
+		return HeadValue
+	}
+
+	return c.TagPrefix + string(ver.GetVersion())
+}
+
+// GetCompareURL returns the URL of changes between the versions
+func (c CompareLinks) GetCompareURL(from, to Version) string {
+	return fmt.Sprintf("%s/compare/%s...%s", strings.TrimRight(c.RepositoryURL, "/"), c.GetTag(from), c.GetTag(to))
+}
+
+// GetTagURL returns the URL of the version tag. It is used for the first version which has nothing to compare with
+func (c CompareLinks) GetTagURL(ver Version) string {
+	return fmt.Sprintf("%s/releases/tag/%s", strings.TrimRight(c.RepositoryURL, "/"), c.GetTag(ver))
+}
+
+// GetLink returns the URL of the link reference definition of the version
+func (l *Changelog) GetLink(ver Version) (string, bool) {
+	url, ok := l.Links[string(ver.GetVersion())]
+
+	return url, ok
+}
+
+// DetectCompareLinks derives the repository URL and the tag prefix from existing compare links of versions
+func (l *Changelog) DetectCompareLinks() (CompareLinks, bool) {
+	for _, ver := range l.GetSortedVersions() {
+		url, _ := l.GetLink(ver)
+
+		match := reCompareLink.FindStringSubmatch(url)
+		if match == nil {
+			continue
+		}
+
+		// Unreleased link compares the latest version with HEAD
+		tag := match[3]
+		if ver.IsUnrealized() {
+			tag, ver = match[2], l.GetLatestVersion()
+		}
+
+		if prefix, ok := strings.CutSuffix(tag, string(ver.GetVersion())); ok {
+			return CompareLinks{RepositoryURL: match[1], TagPrefix: prefix}, true
+		}
+	}
+
+	return CompareLinks{}, false
+}
+
+// UpdateLinks sets links of the versions: compare links with the previous released versions
+// or the tag link for the first version
+func (l *Changelog) UpdateLinks(links CompareLinks, versions ...Version) {
+	if l.Links == nil {
+		l.Links = make(map[string]string)
+	}
+
+	for _, ver := range versions {
+		label := string(ver.GetVersion())
+
+		previous, ok := l.getPreviousVersion(ver)
+		switch {
+		case ok:
+			l.Links[label] = links.GetCompareURL(previous, ver)
+		case ver.IsCommon():
+			l.Links[label] = links.GetTagURL(ver)
+		default:
+			// Nothing is released yet
+			delete(l.Links, label)
+		}
+	}
+}
+
+// getPreviousVersion returns the released version preceding the version
+func (l *Changelog) getPreviousVersion(ver Version) (Version, bool) {
+	for _, v := range l.GetSortedVersions() {
+		if v.IsCommon() && v.LessThen(ver) {
+			return v, true
+		}
+	}
+
+	return Empty, false
+}
+
+// GetNextVersion returns the released version following the version
+func (l *Changelog) GetNextVersion(ver Version) (Version, bool) {
+	versions := l.GetSortedVersions()
+	for i := len(versions) - 1; i >= 0; i-- {
+		if versions[i].IsCommon() && ver.LessThen(versions[i]) {
+			return versions[i], true
+		}
+	}
+
+	return Empty, false
+}
+
+// renderLinks renders link reference definitions: links of versions in order of versions and then other links
+func (l *Changelog) renderLinks() string {
+	output := ""
+	rendered := make(map[string]struct{})
+
+	for _, ver := range l.GetSortedVersions() {
+		if url, ok := l.GetLink(ver); ok {
+			output += fmt.Sprintf("[%s]: %s\n", ver.GetVersion(), url)
+			rendered[string(ver.GetVersion())] = struct{}{}
+		}
+	}
+
+	labels := make([]string, 0, len(l.Links))
+	for label := range l.Links {
+		if _, ok := rendered[label]; !ok {
+			labels = append(labels, label)
+		}
+	}
+	sort.Strings(labels)
+
+	for _, label := range labels {
+		output += fmt.Sprintf("[%s]: %s\n", label, l.Links[label])
+	}
+
+	return strings.TrimSpace(output)
+}
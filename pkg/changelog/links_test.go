@@ -0,0 +1,73 @@
+package changelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestChangelog_DetectCompareLinks(t *testing.T) {
+	convey.Convey("detecting compare links", t, func() {
+		now := time.Now()
+		cl := NewChangelog("", "", map[VersionString]VersionChanges{})
+		_ = cl.Add(RequireVersionFromString("1.0.0", &now), NewChanges())
+		_ = cl.Add(Unreleased, NewChanges())
+
+		convey.Convey("should derive the repository and the tag prefix from Unreleased link", func() {
+			cl.Links["Unreleased"] = "https://gitlab.com/org/repo/-/compare/release-1.0.0...HEAD"
+
+			links, ok := cl.DetectCompareLinks()
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(links, convey.ShouldResemble, CompareLinks{RepositoryURL: "https://gitlab.com/org/repo/-", TagPrefix: "release-"})
+		})
+
+		convey.Convey("should fail without compare links", func() {
+			cl.Links["1.0.0"] = "https://github.com/org/repo/releases/tag/v1.0.0"
+
+			_, ok := cl.DetectCompareLinks()
+			convey.So(ok, convey.ShouldBeFalse)
+		})
+	})
+}
+
+func TestChangelog_UpdateLinks(t *testing.T) {
+	convey.Convey("updating links on release", t, func() {
+		now := time.Now()
+		links := CompareLinks{RepositoryURL: "https://github.com/org/repo/", TagPrefix: "v"}
+		cl := NewChangelog("", "", map[VersionString]VersionChanges{})
+
+		changes := NewChanges()
+		changes.SetEntries(Added, []string{"feature"})
+		_ = cl.Add(Unreleased, changes)
+
+		convey.Convey("should link the first version to its tag", func() {
+			ver := RequireVersionFromString("1.0.0", &now)
+			convey.So(cl.Release(ver), convey.ShouldBeNil)
+			cl.UpdateLinks(links, ver, Unreleased)
+
+			convey.So(cl.Links, convey.ShouldResemble, map[string]string{
+				"Unreleased": "https://github.com/org/repo/compare/v1.0.0...HEAD",
+				"1.0.0":      "https://github.com/org/repo/releases/tag/v1.0.0",
+			})
+
+			convey.Convey("and next versions to the previous ones", func() {
+				cl.Versions[Unreleased.GetVersion()] = NewVersionChanges(Unreleased, changes)
+				ver := RequireVersionFromString("1.1.0", &now)
+				convey.So(cl.Release(ver), convey.ShouldBeNil)
+				cl.UpdateLinks(links, ver, Unreleased)
+
+				convey.So(cl.Links["1.1.0"], convey.ShouldEqual, "https://github.com/org/repo/compare/v1.0.0...v1.1.0")
+				convey.So(cl.Links["Unreleased"], convey.ShouldEqual, "https://github.com/org/repo/compare/v1.1.0...HEAD")
+
+				convey.Convey("and unrelease should remove the link", func() {
+					convey.So(cl.Unrelease(ver), convey.ShouldBeNil)
+					cl.UpdateLinks(links, Unreleased)
+
+					convey.So(cl.Links, convey.ShouldNotContainKey, "1.1.0")
+					convey.So(cl.Links["Unreleased"], convey.ShouldEqual, "https://github.com/org/repo/compare/v1.0.0...HEAD")
+				})
+			})
+		})
+	})
+}
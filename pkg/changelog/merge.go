@@ -0,0 +1,207 @@
+package changelog
+
+// Merge performs three-way merge of changelogs ours and theirs which have the common ancestor base.
+//
+// Entries of Unreleased section are merged per kind: additions and removals of both sides are applied.
+// Released versions are taken from the side that changed them; if both sides changed the same version
+// differently the version is returned in the list of conflicts and both sides of it are rendered
+// between git conflict markers.
+func Merge(base, ours, theirs *Changelog) (*Changelog, []Version) {
+	header, description := ours.Header, ours.Description
+	if header == base.Header {
+		header = theirs.Header
+	}
+	if description == base.Description {
+		description = theirs.Description
+	}
+
+	merged := NewChangelog(header, description, make(map[VersionString]VersionChanges))
+	merged.Links = mergeLinks(base.Links, ours.Links, theirs.Links)
+	merged.conflicts = make(map[VersionString]mergeConflict)
+	merged.breakingMarkers, merged.refPatterns = ours.breakingMarkers, ours.refPatterns
+	var conflicts []Version
+
+	for _, ver := range mergedVersionStrings(base, ours, theirs) {
+		baseVersion, inBase := base.Versions[ver]
+		ourVersion, inOurs := ours.Versions[ver]
+		theirVersion, inTheirs := theirs.Versions[ver]
+
+		if RequireVersionFromString(ver, nil).IsUnrealized() {
+			merged.Versions[ver] = mergeUnreleased(baseVersion, ourVersion, theirVersion)
+			continue
+		}
+
+		switch {
+		case inOurs && inTheirs:
+			switch {
+			case equalVersionChanges(ourVersion, theirVersion), inBase && equalVersionChanges(baseVersion, theirVersion):
+				merged.Versions[ver] = ourVersion
+			case inBase && equalVersionChanges(baseVersion, ourVersion):
+				merged.Versions[ver] = theirVersion
+			default:
+				merged.Versions[ver] = ourVersion
+				merged.conflicts[ver] = mergeConflict{ours: &ourVersion, theirs: &theirVersion}
+				conflicts = append(conflicts, ourVersion.Version)
+			}
+		case inOurs && inBase:
+			// the version was removed by theirs
+			if !equalVersionChanges(baseVersion, ourVersion) {
+				merged.Versions[ver] = ourVersion
+				merged.conflicts[ver] = mergeConflict{ours: &ourVersion}
+				conflicts = append(conflicts, ourVersion.Version)
+			}
+		case inTheirs && inBase:
+			// the version was removed by ours
+			if !equalVersionChanges(baseVersion, theirVersion) {
+				merged.Versions[ver] = theirVersion
+				merged.conflicts[ver] = mergeConflict{theirs: &theirVersion}
+				conflicts = append(conflicts, theirVersion.Version)
+			}
+		case inOurs:
+			merged.Versions[ver] = ourVersion
+		case inTheirs:
+			merged.Versions[ver] = theirVersion
+		}
+	}
+
+	return merged, conflicts
+}
+
+// mergeConflict keeps both sides of the version changed differently by ours and theirs.
+// The side is nil if the version was removed by it
+type mergeConflict struct {
+	ours, theirs *VersionChanges
+}
+
+// Render renders both sides of the version between git conflict markers
+func (c mergeConflict) Render(opts RenderOptions) string {
+	output := "<<<<<<< ours\n"
+	if c.ours != nil {
+		output += c.ours.Render(opts) + "\n"
+	}
+
+	output += "=======\n"
+	if c.theirs != nil {
+		output += c.theirs.Render(opts) + "\n"
+	}
+
+	return output + ">>>>>>> theirs"
+}
+
+// mergeUnreleased merges Unreleased sections keeping the description, headings and order of kinds of ours
+// with the ones added by theirs
+func mergeUnreleased(base, ours, theirs VersionChanges) VersionChanges {
+	merged := NewVersionChanges(Unreleased, mergeChanges(base.Changes, ours.Changes, theirs.Changes))
+
+	merged.Description = ours.Description
+	if merged.Description == base.Description {
+		merged.Description = theirs.Description
+	}
+
+	merged.Order = append(append([]ChangesKind(nil), ours.Order...), theirs.Order...)
+
+	for _, headings := range []map[ChangesKind]string{theirs.Headings, ours.Headings} {
+		for kind, heading := range headings {
+			if merged.Headings == nil {
+				merged.Headings = make(map[ChangesKind]string)
+			}
+			merged.Headings[kind] = heading
+		}
+	}
+
+	return merged
+}
+
+// mergeChanges merges entries per kind. The kind changed by one side only is taken from it as is,
+// otherwise entries of ours without entries removed by theirs and with entries added by theirs are used
+func mergeChanges(base, ours, theirs Changes) Changes {
+	merged := NewChanges()
+
+	kinds := ours.GetKinds()
+	for _, kind := range theirs.GetKinds() {
+		if !ours.Has(kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+
+	for _, kind := range kinds {
+		switch {
+		case theirs.Get(kind) == base.Get(kind):
+			merged.Set(kind, ours.Get(kind))
+			continue
+		case ours.Get(kind) == base.Get(kind):
+			merged.Set(kind, theirs.Get(kind))
+			continue
+		}
+
+		removedByTheirs := subtractEntries(base.GetEntries(kind), theirs.GetEntries(kind))
+		addedByTheirs := subtractEntries(theirs.GetEntries(kind), base.GetEntries(kind))
+
+		entries := subtractEntries(ours.GetEntries(kind), removedByTheirs)
+		entries = append(entries, subtractEntries(addedByTheirs, ours.GetEntries(kind))...)
+
+		merged.SetEntries(kind, entries)
+	}
+
+	return merged
+}
+
+func mergedVersionStrings(changelogs ...*Changelog) []VersionString {
+	var versions []VersionString
+
+	seen := make(map[VersionString]struct{})
+	for _, cl := range changelogs {
+		for _, ver := range cl.GetSortedVersions() {
+			if _, ok := seen[ver.GetVersion()]; ok {
+				continue
+			}
+
+			seen[ver.GetVersion()] = struct{}{}
+			versions = append(versions, ver.GetVersion())
+		}
+	}
+
+	return versions
+}
+
+func equalVersionChanges(a, b VersionChanges) bool {
+	return a.Version.GetDate().Equal(b.Version.GetDate()) &&
+		a.Version.IsYanked() == b.Version.IsYanked() &&
+		a.Description == b.Description &&
+		compareChanges(a.Changes, b.Changes).IsEmpty()
+}
+
+// mergeLinks merges link reference definitions taking each link from the side that changed it
+func mergeLinks(base, ours, theirs map[string]string) map[string]string {
+	merged := make(map[string]string)
+
+	for label, url := range ours {
+		if baseURL, ok := base[label]; ok && url == baseURL {
+			if theirURL, ok := theirs[label]; ok {
+				url = theirURL
+			} else {
+				// the link was removed by theirs
+				continue
+			}
+		}
+
+		merged[label] = url
+	}
+
+	for label, url := range theirs {
+		if _, ok := merged[label]; ok {
+			continue
+		}
+
+		// the link was removed by ours
+		if _, ok := base[label]; ok {
+			if _, inOurs := ours[label]; !inOurs {
+				continue
+			}
+		}
+
+		merged[label] = url
+	}
+
+	return merged
+}
@@ -0,0 +1,110 @@
+package changelog
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestMerge(t *testing.T) {
+	date := time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC)
+
+	newChangelog := func(unreleased []string, released map[VersionString][]string) *Changelog {
+		cl := NewChangelog("# Changelog", "", map[VersionString]VersionChanges{})
+
+		changes := NewChanges()
+		changes.SetEntries(Added, unreleased)
+		_ = cl.Add(Unreleased, changes)
+
+		for ver, entries := range released {
+			changes := NewChanges()
+			changes.SetEntries(Fixed, entries)
+			_ = cl.Add(RequireVersionFromString(ver, &date), changes)
+		}
+
+		return cl
+	}
+
+	convey.Convey("merging changelogs", t, func() {
+		base := newChangelog([]string{"base"}, map[VersionString][]string{"1.0.0": {"fix"}})
+
+		convey.Convey("should union unreleased entries of both sides", func() {
+			ours := newChangelog([]string{"base", "ours"}, map[VersionString][]string{"1.0.0": {"fix"}})
+			theirs := newChangelog([]string{"theirs"}, map[VersionString][]string{"1.0.0": {"fix"}, "1.1.0": {"new"}})
+
+			merged, conflicts := Merge(base, ours, theirs)
+			convey.So(conflicts, convey.ShouldBeEmpty)
+
+			unreleased, _ := merged.GetChanges(Unreleased)
+			convey.So(unreleased.GetEntries(Added), convey.ShouldResemble, []string{"ours", "theirs"})
+			convey.So(merged.GetLatestVersion().GetVersion(), convey.ShouldEqual, "1.1.0")
+		})
+
+		convey.Convey("should report conflicts of released versions", func() {
+			ours := newChangelog([]string{"base"}, map[VersionString][]string{"1.0.0": {"ours fix"}})
+			theirs := newChangelog([]string{"base"}, map[VersionString][]string{"1.0.0": {"their fix"}})
+
+			merged, conflicts := Merge(base, ours, theirs)
+			convey.So(conflicts, convey.ShouldHaveLength, 1)
+			convey.So(conflicts[0].GetVersion(), convey.ShouldEqual, "1.0.0")
+
+			changes, _ := merged.GetChanges(conflicts[0])
+			convey.So(changes.GetEntries(Fixed), convey.ShouldResemble, []string{"ours fix"})
+
+			convey.So(merged.Render(RenderOptions{}), convey.ShouldContainSubstring, `<<<<<<< ours
+## [1.0.0] - 2024-01-29
+
+### Fixed
+- ours fix
+=======
+## [1.0.0] - 2024-01-29
+
+### Fixed
+- their fix
+>>>>>>> theirs`)
+		})
+
+		convey.Convey("should keep the conflicting version removed by one side", func() {
+			ours := newChangelog([]string{"base"}, map[VersionString][]string{"1.0.0": {"ours fix"}})
+			theirs := newChangelog([]string{"base"}, nil)
+
+			merged, conflicts := Merge(base, ours, theirs)
+			convey.So(conflicts, convey.ShouldHaveLength, 1)
+			convey.So(merged.Render(RenderOptions{}), convey.ShouldContainSubstring, "- ours fix\n=======\n>>>>>>> theirs")
+		})
+
+		convey.Convey("should keep the order of kinds and the source of entries", func() {
+			source := `# Changelog
+
+## [Unreleased]
+
+### Fixed
+* fix with [link](https://example.com)
+  - nested item
+
+### Added
+- base
+`
+			base, _ := Parse([]byte(source))
+			ours, _ := Parse([]byte(strings.Replace(source, "- base", "- base\n- ours", 1)))
+			theirs, _ := Parse([]byte(strings.Replace(source, "- base", "- base\n- theirs", 1)))
+
+			merged, conflicts := Merge(base, ours, theirs)
+			convey.So(conflicts, convey.ShouldBeEmpty)
+			convey.So(merged.Render(RenderOptions{}), convey.ShouldEqual, `# Changelog
+
+## [Unreleased]
+
+### Fixed
+* fix with [link](https://example.com)
+  - nested item
+
+### Added
+- base
+- ours
+- theirs`)
+		})
+	})
+}
@@ -0,0 +1,85 @@
+package changelog
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// DuplicatesMerge concatenates entries of repeated sections of the same version or kind in order of appearance
+	DuplicatesMerge DuplicatesPolicy = "merge"
+	// DuplicatesError makes reading fail with ParseError on repeated sections
+	DuplicatesError DuplicatesPolicy = "error"
+)
+
+var ErrInvalidDuplicatesPolicy = errors.New("invalid duplicates policy")
+
+// DuplicatesPolicy defines how repeated sections of the same version or kind of changes are handled
+type DuplicatesPolicy string
+
+func NewDuplicatesPolicy(policy string) (DuplicatesPolicy, error) {
+	switch DuplicatesPolicy(policy) {
+	case DuplicatesMerge, DuplicatesError:
+		return DuplicatesPolicy(policy), nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidDuplicatesPolicy, policy)
+	}
+}
+
+// Option configures reading of the changelog
+type Option func(*options)
+
+type options struct {
+	strict          bool
+	duplicates      DuplicatesPolicy
+	kinds           KindResolver
+	breakingMarkers []string
+	refPatterns     []RefPattern
+}
+
+func newOptions(opts []Option) options {
+	o := options{duplicates: DuplicatesMerge, kinds: defaultKinds}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// WithStrict makes reading fail with ParseError on content which is dropped otherwise:
+// level 2 headings which are not versions and content before the first version
+func WithStrict(strict bool) Option {
+	return func(o *options) {
+		o.strict = strict
+	}
+}
+
+// WithDuplicates sets the policy for repeated sections of the same version or kind of changes (DuplicatesMerge by default)
+func WithDuplicates(policy DuplicatesPolicy) Option {
+	return func(o *options) {
+		o.duplicates = policy
+	}
+}
+
+// WithKindAliases adds aliases of headings of kinds of changes to KindAliases (see NewKindResolver)
+func WithKindAliases(aliases map[string]ChangesKind) Option {
+	return func(o *options) {
+		o.kinds = NewKindResolver(KindAliases, aliases)
+	}
+}
+
+// WithBreakingMarkers replaces BreakingMarkers used for the changelog. They are applied by Release and on rendering
+// unless RenderOptions.BreakingMarkers is set
+func WithBreakingMarkers(markers ...string) Option {
+	return func(o *options) {
+		o.breakingMarkers = markers
+	}
+}
+
+// WithRefPatterns replaces RefPatterns used for the changelog. They are applied on rendering
+// unless RenderOptions.RefPatterns is set
+func WithRefPatterns(patterns ...RefPattern) Option {
+	return func(o *options) {
+		o.refPatterns = patterns
+	}
+}
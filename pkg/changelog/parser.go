@@ -0,0 +1,401 @@
+package changelog
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+const (
+	versionLevel     = 2
+	changesKindLevel = 3
+)
+
+var (
+	rePrepareContent    = regexp.MustCompile(`(\s*\r?\n)+(#{2,3})\s`)
+	reLinkDefinition    = regexp.MustCompile(`^ {0,3}\[[^\]]+\]:\s*\S`)
+	reLeadingBlankLines = regexp.MustCompile(`^([ \t]*\n)+`)
+)
+
+var (
+	ErrInvalidVersionHeading = errors.New("heading is not a valid version")
+	ErrUnexpectedContent     = errors.New("content is outside of versions")
+	ErrDuplicateVersion      = errors.New("version is defined more than once")
+	ErrDuplicateKind         = errors.New("kind of changes is defined more than once in the version")
+)
+
+// ParseError is an error of parsing the changelog at the line of the source
+type ParseError struct {
+	Line int
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Parse parses the changelog in markdown format. Content of versions and kinds of changes is kept as is
+// (including nested lists and other markup), so rendering of the parsed changelog reproduces the source
+func Parse(content []byte, opts ...Option) (*Changelog, error) {
+	p := &markdownParser{options: newOptions(opts)}
+	p.src, p.inserted = prepareContent(content)
+	p.footer = footerOffset(p.src)
+
+	ctx := parser.NewContext()
+	tree := goldmark.DefaultParser().Parse(text.NewReader(p.src), parser.WithContext(ctx))
+
+	header, description, skip := p.readHeader(tree)
+
+	versions, err := p.readVersions(tree, skip)
+	if err != nil {
+		return nil, err
+	}
+
+	cl := NewChangelog(header, description, versions)
+	cl.Links = readLinks(ctx)
+	cl.breakingMarkers = p.options.breakingMarkers
+	cl.refPatterns = p.options.refPatterns
+
+	return cl, nil
+}
+
+type markdownParser struct {
+	options options
+	src     []byte
+	// inserted are offsets of line breaks added to the source by prepareContent
+	inserted []int
+	// footer is the offset of link reference definitions at the end of the source
+	footer int
+}
+
+// prepareContent separates headings of versions and kinds from the previous content by blank lines.
+// It returns the prepared content and offsets of inserted line breaks
+func prepareContent(content []byte) ([]byte, []int) {
+	var inserted []int
+
+	output := make([]byte, 0, len(content))
+	last := 0
+
+	for _, loc := range rePrepareContent.FindAllSubmatchIndex(content, -1) {
+		output = append(output, content[last:loc[4]]...)
+		if bytes.Count(content[loc[0]:loc[4]], []byte("\n")) < 2 {
+			inserted = append(inserted, len(output))
+			output = append(output, '\n')
+		}
+		last = loc[4]
+	}
+
+	return append(output, content[last:]...), inserted
+}
+
+// footerOffset returns the offset of trailing lines of link reference definitions (they are read as Links)
+func footerOffset(src []byte) int {
+	footer := len(src)
+
+	for footer > 0 {
+		start := bytes.LastIndexByte(src[:footer-1], '\n') + 1
+		line := bytes.TrimSpace(src[start:footer])
+		if len(line) > 0 && !reLinkDefinition.Match(line) {
+			break
+		}
+
+		footer = start
+	}
+
+	return footer
+}
+
+// readHeader reads the header and the description (paragraphs and HTML blocks like comments) until first version
+func (p *markdownParser) readHeader(tree ast.Node) (header, description string, skip int) {
+	start := 0
+	end := p.footer
+
+	i := 0
+	for node := tree.FirstChild(); node != nil; node = node.NextSibling() {
+		i++
+
+		switch v := node.(type) {
+		case *ast.Heading:
+			if v.Level == 1 && header == "" && skip == i-1 {
+				header = "# " + renderSource(p.src, node, "\n")
+				start = p.lineEnd(node)
+				skip = i
+				continue
+			}
+		case *ast.Paragraph, *ast.HTMLBlock, *ast.TextBlock:
+			skip = i
+			continue
+		default:
+		}
+
+		if offset := p.lineStart(node); offset >= 0 {
+			end = offset
+		}
+
+		break
+	}
+
+	return strings.TrimSpace(header), p.rawContent(start, end), skip
+}
+
+func (p *markdownParser) readVersions(tree ast.Node, skip int) (map[VersionString]VersionChanges, error) {
+	versions := make(map[VersionString]VersionChanges)
+
+	var ver *Version
+	var kind *ChangesKind
+
+	// start is the offset of content of the current version or kind of changes (after its heading)
+	start := -1
+
+	// flush adds content of the current version or kind of changes until the offset
+	flush := func(end int) {
+		if ver == nil || start < 0 {
+			return
+		}
+
+		content := p.rawContent(start, end)
+		if content == "" {
+			return
+		}
+
+		section := versions[ver.GetVersion()]
+		if kind == nil {
+			// Content between the version heading and the first kind of changes
+			section.Description = strings.TrimSpace(section.Description + "\n\n" + content)
+		} else {
+			// Repeated sections of the same kind are concatenated in order
+			if section.Changes.Has(*kind) {
+				content = section.Changes.Get(*kind) + "\n" + content
+			}
+			section.Changes.Set(*kind, content)
+		}
+		versions[ver.GetVersion()] = section
+	}
+
+	// Lines of headings of versions and kinds for reporting duplicates
+	versionLines := make(map[VersionString]int)
+	kindLines := make(map[VersionString]map[ChangesKind]int)
+
+	i := 0
+	for node := tree.FirstChild(); node != nil; node = node.NextSibling() {
+		i++
+		if i <= skip {
+			continue
+		}
+
+		v, err := NewVersionFromNode(p.src, node, versionLevel)
+		if err == nil {
+			flush(p.lineStart(node))
+
+			ver = &v
+			kind = nil
+			start = p.lineEnd(node)
+
+			if line, exist := versionLines[ver.GetVersion()]; exist {
+				if p.options.duplicates == DuplicatesError {
+					return nil, p.newError(node, fmt.Errorf("%w: %s (first defined at line %d)", ErrDuplicateVersion, ver.GetVersion(), line))
+				}
+				continue
+			}
+
+			versionLines[ver.GetVersion()] = p.lineOf(node)
+			kindLines[ver.GetVersion()] = make(map[ChangesKind]int)
+			versions[ver.GetVersion()] = NewVersionChanges(*ver, NewChanges())
+			continue
+		}
+
+		if p.options.strict && isHeading(node, versionLevel) {
+			return nil, p.newError(node, fmt.Errorf("%w: %s", ErrInvalidVersionHeading, renderSource(p.src, node, " ")))
+		}
+
+		// Paragraphs of link reference definitions are left empty by the parser
+		if p.lineStart(node) < 0 {
+			continue
+		}
+
+		if ver == nil {
+			if p.options.strict {
+				return nil, p.newError(node, ErrUnexpectedContent)
+			}
+
+			// For correct Changelog structure it never should happen
+			continue
+		}
+
+		k, err := newChangesKindFromNode(p.src, node, changesKindLevel, p.options.kinds)
+		if err != nil {
+			// Other blocks are kept as is in content of the version or the kind
+			continue
+		}
+
+		flush(p.lineStart(node))
+
+		kind = &k
+		start = p.lineEnd(node)
+
+		if line, exist := kindLines[ver.GetVersion()][k]; exist {
+			if p.options.duplicates == DuplicatesError {
+				return nil, p.newError(node, fmt.Errorf("%w: %s %s (first defined at line %d)", ErrDuplicateKind, ver.GetVersion(), k, line))
+			}
+			continue
+		}
+
+		kindLines[ver.GetVersion()][k] = p.lineOf(node)
+
+		section := versions[ver.GetVersion()]
+		section.Order = append(section.Order, k)
+		if heading := renderSource(p.src, node, " "); heading != string(k) {
+			if section.Headings == nil {
+				section.Headings = make(map[ChangesKind]string)
+			}
+			section.Headings[k] = heading
+		}
+		versions[ver.GetVersion()] = section
+	}
+
+	flush(p.footer)
+
+	return versions, nil
+}
+
+// rawContent returns the source between offsets (limited by the footer) without surrounding blank lines
+func (p *markdownParser) rawContent(start, end int) string {
+	end = min(end, p.footer)
+	if start >= end {
+		return ""
+	}
+
+	var raw []byte
+	for _, offset := range p.inserted {
+		if offset >= start && offset < end {
+			raw = append(raw, p.src[start:offset]...)
+			start = offset + 1
+		}
+	}
+	raw = append(raw, p.src[start:end]...)
+
+	content := strings.ReplaceAll(string(raw), "\r\n", "\n")
+	content = reLeadingBlankLines.ReplaceAllString(content, "")
+
+	return strings.TrimRightFunc(content, unicode.IsSpace)
+}
+
+// lineStart returns the offset of the beginning of the first line of the node (including markers of lists,
+// quotes and headings) or -1 if the node has no content
+func (p *markdownParser) lineStart(node ast.Node) int {
+	offset := -1
+
+	_ = ast.Walk(node, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if entering && n.Type() == ast.TypeBlock && n.Lines().Len() > 0 {
+			offset = n.Lines().At(0).Start
+			return ast.WalkStop, nil
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	if offset < 0 {
+		return offset
+	}
+
+	return bytes.LastIndexByte(p.src[:offset], '\n') + 1
+}
+
+// lineEnd returns the offset after the first line of the node (e.g. after the heading)
+func (p *markdownParser) lineEnd(node ast.Node) int {
+	start := p.lineStart(node)
+	if start < 0 {
+		return start
+	}
+
+	if end := bytes.IndexByte(p.src[start:], '\n'); end >= 0 {
+		return start + end + 1
+	}
+
+	return len(p.src)
+}
+
+// newError returns the error at the line of the node in the original content
+func (p *markdownParser) newError(node ast.Node, err error) error {
+	return &ParseError{Line: p.lineOf(node), Err: err}
+}
+
+// lineOf returns the line of the node in the original content
+func (p *markdownParser) lineOf(node ast.Node) int {
+	offset := 0
+
+	_ = ast.Walk(node, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if entering && n.Type() == ast.TypeBlock && n.Lines().Len() > 0 {
+			offset = n.Lines().At(0).Start
+			return ast.WalkStop, nil
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	inserted := sort.SearchInts(p.inserted, offset)
+
+	return bytes.Count(p.src[:offset], []byte("\n")) - inserted + 1
+}
+
+// readLinks reads link reference definitions (e.g. "[1.0.0]: https://...") by their labels.
+// Labels of versions are normalized to be the same as versions in the changelog
+func readLinks(ctx parser.Context) map[string]string {
+	links := make(map[string]string)
+
+	for _, ref := range ctx.References() {
+		label := string(ref.Label())
+		if ver, err := NewVersion(VersionString(label), nil); err == nil && !ver.IsLatest() {
+			label = string(ver.GetVersion())
+			if ver.IsUnrealized() {
+				label = string(UnreleasedValue)
+			}
+		}
+
+		links[label] = string(ref.Destination())
+	}
+
+	return links
+}
+
+func isHeading(node ast.Node, level int) bool {
+	h, ok := node.(*ast.Heading)
+
+	return ok && h.Level == level
+}
+
+// renderSource returns markdown source of the node (with inline markup like links and code spans)
+// and its descendant blocks. Lines are trimmed and joined with the separator
+func renderSource(src []byte, node ast.Node, separator string) string {
+	var lines []string
+
+	_ = ast.Walk(node, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering || n.Type() != ast.TypeBlock {
+			return ast.WalkContinue, nil
+		}
+
+		for i := 0; i < n.Lines().Len(); i++ {
+			segment := n.Lines().At(i)
+			if line := strings.TrimSpace(string(segment.Value(src))); line != "" {
+				lines = append(lines, line)
+			}
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return strings.Join(lines, separator)
+}
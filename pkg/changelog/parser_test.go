@@ -0,0 +1,88 @@
+package changelog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestParse_Duplicates(t *testing.T) {
+	const md = "## [Unreleased]\n### Fixed\n- fix 1\n### Added\n- feature\n### Fixed\n- fix 2\n\n" +
+		"## [1.0.0] - 2024-01-01\n\nFirst.\n\n### Fixed\n- fix 3\n## [1.0.0] - 2024-01-01\n\nSecond.\n\n### Fixed\n- fix 4\n"
+
+	convey.Convey("parsing changelog with repeated sections", t, func() {
+		convey.Convey("should merge entries in order by default", func() {
+			cl, err := Parse([]byte(md))
+			convey.So(err, convey.ShouldBeNil)
+
+			unreleased, _ := cl.GetChanges(Unreleased)
+			convey.So(unreleased.GetEntries(Fixed), convey.ShouldResemble, []string{"fix 1", "fix 2"})
+			convey.So(unreleased.GetEntries(Added), convey.ShouldResemble, []string{"feature"})
+
+			released := cl.Versions["1.0.0"]
+			convey.So(released.Description, convey.ShouldEqual, "First.\n\nSecond.")
+			convey.So(released.Changes.GetEntries(Fixed), convey.ShouldResemble, []string{"fix 3", "fix 4"})
+		})
+
+		convey.Convey("should report the repeated kind with its position", func() {
+			_, err := Parse([]byte(md), WithDuplicates(DuplicatesError))
+
+			var parseErr *ParseError
+			convey.So(errors.As(err, &parseErr), convey.ShouldBeTrue)
+			convey.So(parseErr.Line, convey.ShouldEqual, 6)
+			convey.So(errors.Is(err, ErrDuplicateKind), convey.ShouldBeTrue)
+			convey.So(err.Error(), convey.ShouldContainSubstring, "first defined at line 2")
+		})
+
+		convey.Convey("should report the repeated version with its position", func() {
+			_, err := Parse([]byte(md[len("## [Unreleased]\n### Fixed\n- fix 1\n### Added\n- feature\n### Fixed\n- fix 2\n\n"):]), WithDuplicates(DuplicatesError))
+
+			var parseErr *ParseError
+			convey.So(errors.As(err, &parseErr), convey.ShouldBeTrue)
+			convey.So(parseErr.Line, convey.ShouldEqual, 7)
+			convey.So(errors.Is(err, ErrDuplicateVersion), convey.ShouldBeTrue)
+		})
+	})
+}
+
+func TestNewDuplicatesPolicy(t *testing.T) {
+	convey.Convey("parsing duplicates policy", t, func() {
+		policy, err := NewDuplicatesPolicy("error")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(policy, convey.ShouldEqual, DuplicatesError)
+
+		_, err = NewDuplicatesPolicy("ignore")
+		convey.So(errors.Is(err, ErrInvalidDuplicatesPolicy), convey.ShouldBeTrue)
+	})
+}
+
+func TestParse_Options(t *testing.T) {
+	const md = "## [Unreleased]\n### Bugs\n- [!] drop API T42\n"
+
+	convey.Convey("parsing changelog with options", t, func() {
+		pattern, err := NewRefPattern(`ticket=\bT\d+\b`)
+		convey.So(err, convey.ShouldBeNil)
+
+		cl, err := Parse([]byte(md),
+			WithKindAliases(map[string]ChangesKind{"Bugs": Fixed}),
+			WithBreakingMarkers("[!]"),
+			WithRefPatterns(pattern),
+		)
+		convey.So(err, convey.ShouldBeNil)
+
+		unreleased, _ := cl.GetChanges(Unreleased)
+		convey.So(unreleased.GetEntries(Fixed), convey.ShouldResemble, []string{"[!] drop API T42"})
+
+		convey.Convey("should use markers and patterns on rendering", func() {
+			opts := RenderOptions{GroupBreaking: true, LinkTemplates: map[RefKind]string{"ticket": "https://t/{id}"}}
+			convey.So(cl.Render(opts), convey.ShouldEqual, "## [Unreleased]\n\n### Breaking changes\n- drop API [T42](https://t/T42)")
+		})
+
+		convey.Convey("should not change defaults", func() {
+			convey.So(ResolveKind("Bugs"), convey.ShouldEqual, ChangesKind("Bugs"))
+			convey.So(unreleased.GetMajority(), convey.ShouldEqual, PatchChanges)
+			convey.So(unreleased.GetMajority("[!]"), convey.ShouldEqual, MajorChanges)
+		})
+	})
+}
@@ -0,0 +1,197 @@
+package changelog
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	IssueRef       RefKind = "issue"        // #123 or link to GitHub/GitLab issue
+	PullRequestRef RefKind = "pull_request" // !45 or link to GitHub pull request or GitLab merge request
+	TrackerRef     RefKind = "tracker"      // PROJ-987
+)
+
+// RefPatterns are default patterns for extracting references from entries. The first capturing group of the pattern
+// (or the whole match if the pattern has no groups) is the ID of the reference, matches without the first group
+// are skipped. Patterns are applied in the order, parts of the text matched by a pattern are not matched
+// by the next ones. Custom patterns are passed by WithRefPatterns and RenderOptions.RefPatterns
+var RefPatterns = []RefPattern{
+	{Kind: IssueRef, Pattern: regexp.MustCompile(`https?://github\.com/([\w.-]+/[\w.-]+/issues/\d+)`), IsURL: true},
+	{Kind: PullRequestRef, Pattern: regexp.MustCompile(`https?://github\.com/([\w.-]+/[\w.-]+/pull/\d+)`), IsURL: true},
+	{Kind: IssueRef, Pattern: regexp.MustCompile(`https?://[\w.-]+(?::\d+)?/([\w./-]+/-/issues/\d+)`), IsURL: true},
+	{Kind: PullRequestRef, Pattern: regexp.MustCompile(`https?://[\w.-]+(?::\d+)?/([\w./-]+/-/merge_requests/\d+)`), IsURL: true},
+	{Kind: IssueRef, Pattern: regexp.MustCompile(`(?:^|[^\w&/#])(#\d+)\b`)},
+	{Kind: PullRequestRef, Pattern: regexp.MustCompile(`(?:^|[^\w/!])(!\d+)\b`)},
+	// Keys of trackers are neither parts of longer dashed words (CVE-2024-1234, PROJ-1-beta)
+	// nor well-known names of standards (UTF-8, SHA-256)
+	{Kind: TrackerRef, Pattern: regexp.MustCompile(`(?:^|[^\w-])(?:` + nonTrackerKeys + `-\d+|[A-Z][A-Z0-9]+(?:-\w+){2,}|([A-Z][A-Z0-9]+-\d+))\b`)},
+}
+
+// nonTrackerKeys are prefixes of names of standards and identifiers which look like keys of trackers
+const nonTrackerKeys = `(?:AES|CVE|CWE|GHSA|HTTP|IPV|ISO|MD|RFC|RSA|SHA|SSL|TLS|UTF)`
+
+// reProtected matches parts of markdown which shouldn't be linkified: links, autolinks, code spans and bare URLs
+var reProtected = regexp.MustCompile("\\[[^\\]]*](\\([^)]*\\)|\\[[^\\]]*])|<[a-z]+://[^>]*>|`[^`]*`|[a-z]+://[^\\s)]+")
+
+var ErrInvalidRefPattern = errors.New("invalid reference pattern")
+
+// RefKind is the kind of reference (issue, pull request, etc.)
+type RefKind string
+
+// RefPattern is a regular expression for extracting references of the kind
+type RefPattern struct {
+	Kind    RefKind
+	Pattern *regexp.Regexp
+	// IsURL means that the whole match is URL of the reference. Otherwise matches are URLs only if they are
+	// absolute URLs (see isAbsoluteURL)
+	IsURL bool
+}
+
+// Ref is a reference to an issue, pull request, etc. mentioned in the entry
+type Ref struct {
+	Kind RefKind `json:"kind"`
+	ID   string  `json:"id"`
+	URL  string  `json:"url,omitempty"`
+}
+
+// NewRefPattern parses pattern in the format "kind=regexp"
+func NewRefPattern(src string) (RefPattern, error) {
+	kind, expr, ok := strings.Cut(src, "=")
+	if !ok || kind == "" || expr == "" {
+		return RefPattern{}, ErrInvalidRefPattern
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return RefPattern{}, err
+	}
+
+	return RefPattern{Kind: RefKind(kind), Pattern: re}, nil
+}
+
+// isAbsoluteURL reports whether the text is an absolute URL with a host (e.g. "https://tracker.local/browse/PROJ-1")
+func isAbsoluteURL(text string) bool {
+	u, err := url.Parse(text)
+
+	return err == nil && u.IsAbs() && u.Host != ""
+}
+
+// ParseRefs extracts references from the text by patterns (RefPatterns if they aren't passed)
+// in the order of their appearance
+func ParseRefs(text string, patterns ...RefPattern) []Ref {
+	var refs []Ref
+
+	seen := make(map[Ref]struct{})
+	for _, match := range findRefs(text, nil, patterns) {
+		if _, ok := seen[match.ref]; ok {
+			continue
+		}
+
+		seen[match.ref] = struct{}{}
+		refs = append(refs, match.ref)
+	}
+
+	return refs
+}
+
+// Linkify replaces bare references (not URLs, not inside links or code spans) by markdown links.
+// Templates map kinds of references to URL templates with placeholders {id} (ID without leading "#" or "!")
+// and {ref} (ID as is). Kind "" is the template for all kinds. References are found by patterns
+// (RefPatterns if they aren't passed)
+func Linkify(text string, templates map[RefKind]string, patterns ...RefPattern) string {
+	if len(templates) == 0 {
+		return text
+	}
+
+	output := ""
+	last := 0
+
+	for _, match := range findRefs(text, reProtected.FindAllStringIndex(text, -1), patterns) {
+		if match.ref.URL != "" {
+			continue
+		}
+
+		template, ok := templates[match.ref.Kind]
+		if !ok {
+			template, ok = templates[""]
+		}
+		if !ok {
+			continue
+		}
+
+		url := strings.NewReplacer(
+			"{id}", strings.TrimLeft(match.ref.ID, "#!"),
+			"{ref}", match.ref.ID,
+		).Replace(template)
+
+		output += text[last:match.start] + fmt.Sprintf("[%s](%s)", match.ref.ID, url)
+		last = match.end
+	}
+
+	return output + text[last:]
+}
+
+type refMatch struct {
+	start, end int
+	ref        Ref
+}
+
+// findRefs returns references matched by patterns (RefPatterns if they are nil) sorted by position.
+// Matches intersecting with excluded ranges are skipped
+func findRefs(text string, excluded [][]int, patterns []RefPattern) []refMatch {
+	var found []refMatch
+
+	if patterns == nil {
+		patterns = RefPatterns
+	}
+
+	isExcluded := func(start, end int) bool {
+		for _, r := range excluded {
+			if start < r[1] && end > r[0] {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, pattern := range patterns {
+		for _, loc := range pattern.Pattern.FindAllStringSubmatchIndex(text, -1) {
+			start, end := loc[0], loc[1]
+			if pattern.Pattern.NumSubexp() > 0 {
+				if loc[2] < 0 {
+					continue
+				}
+
+				start, end = loc[2], loc[3]
+			}
+
+			if isExcluded(start, end) {
+				continue
+			}
+			excluded = append(excluded, []int{loc[0], loc[1]})
+
+			match := refMatch{start: start, end: end, ref: Ref{Kind: pattern.Kind, ID: text[start:end]}}
+			if pattern.IsURL || isAbsoluteURL(text[loc[0]:loc[1]]) {
+				match.ref.URL = text[loc[0]:loc[1]]
+			}
+
+			found = append(found, match)
+		}
+	}
+
+	sort.SliceStable(found, func(i, j int) bool {
+		return found[i].start < found[j].start
+	})
+
+	return found
+}
+
+// GetRefs returns references mentioned in the entry by patterns (RefPatterns if they aren't passed)
+func (e Entry) GetRefs(patterns ...RefPattern) []Ref {
+	return ParseRefs(e.Text, patterns...)
+}
@@ -0,0 +1,72 @@
+package changelog
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseRefs(t *testing.T) {
+	convey.Convey("parsing references", t, func() {
+		refs := ParseRefs("Fixed PROJ-987 and #123 (see !45, https://github.com/org/repo/pull/7 and https://gitlab.local/group/repo/-/issues/8#note_1), not &#39;")
+
+		convey.So(refs, convey.ShouldResemble, []Ref{
+			{Kind: TrackerRef, ID: "PROJ-987"},
+			{Kind: IssueRef, ID: "#123"},
+			{Kind: PullRequestRef, ID: "!45"},
+			{Kind: PullRequestRef, ID: "org/repo/pull/7", URL: "https://github.com/org/repo/pull/7"},
+			{Kind: IssueRef, ID: "group/repo/-/issues/8", URL: "https://gitlab.local/group/repo/-/issues/8"},
+		})
+
+		convey.Convey("should not treat names of standards and dashed words as tracker keys", func() {
+			convey.So(ParseRefs("Support UTF-8 and SHA-256 (CVE-2024-1234, X-PROJ-1, PROJ-2-beta)"), convey.ShouldBeEmpty)
+			convey.So(ParseRefs("Fixed PROJ-1 PROJ-2, AB-3."), convey.ShouldResemble, []Ref{
+				{Kind: TrackerRef, ID: "PROJ-1"},
+				{Kind: TrackerRef, ID: "PROJ-2"},
+				{Kind: TrackerRef, ID: "AB-3"},
+			})
+			convey.So(Linkify("Fixed CVE-2024-1234", map[RefKind]string{TrackerRef: "https://jira.local/browse/{id}"}),
+				convey.ShouldEqual, "Fixed CVE-2024-1234")
+		})
+
+		convey.Convey("should use custom patterns", func() {
+			pattern, err := NewRefPattern(`ticket=\bT(\d+)\b`)
+			convey.So(err, convey.ShouldBeNil)
+
+			convey.So(ParseRefs("Fixed T42 and #1", pattern), convey.ShouldResemble, []Ref{{Kind: "ticket", ID: "42"}})
+			convey.So(Linkify("Fixed T42", map[RefKind]string{"": "https://t/{id}"}, pattern), convey.ShouldEqual, "Fixed T[42](https://t/42)")
+		})
+
+		convey.Convey("should keep URL of matches which are absolute URLs", func() {
+			url, err := NewRefPattern(`jira=https://jira\.local/browse/([A-Z]+-\d+)`)
+			convey.So(err, convey.ShouldBeNil)
+			plain, err := NewRefPattern(`build=\bhttpd-(\d+)\b`)
+			convey.So(err, convey.ShouldBeNil)
+
+			convey.So(ParseRefs("See https://jira.local/browse/CORE-7 and httpd-3", url, plain), convey.ShouldResemble, []Ref{
+				{Kind: "jira", ID: "CORE-7", URL: "https://jira.local/browse/CORE-7"},
+				{Kind: "build", ID: "3"},
+			})
+		})
+	})
+}
+
+func TestLinkify(t *testing.T) {
+	templates := map[RefKind]string{
+		TrackerRef: "https://tracker.local/browse/{id}",
+		IssueRef:   "https://github.com/org/repo/issues/{id}",
+	}
+
+	texts := map[string]string{
+		"Fixed PROJ-1 and #12":                    "Fixed [PROJ-1](https://tracker.local/browse/PROJ-1) and [#12](https://github.com/org/repo/issues/12)",
+		"Fixed [PROJ-1](https://x/PROJ-1) and !3": "Fixed [PROJ-1](https://x/PROJ-1) and !3",
+		"See `PROJ-1` and https://x/PROJ-1":       "See `PROJ-1` and https://x/PROJ-1",
+		"Fixed [#12][issue]":                      "Fixed [#12][issue]",
+	}
+
+	for text, expected := range texts {
+		convey.Convey(text, t, func() {
+			convey.So(Linkify(text, templates), convey.ShouldEqual, expected)
+		})
+	}
+}
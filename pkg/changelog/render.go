@@ -0,0 +1,122 @@
+package changelog
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BreakingChangesTitle is the heading of the group of breaking changes
+const BreakingChangesTitle = "Breaking changes"
+
+// RenderOptions are transformations applied on rendering the changelog for displaying.
+// Zero value renders the changelog as is
+type RenderOptions struct {
+	// GroupBreaking moves entries marked by breaking markers to the dedicated "Breaking changes" group
+	GroupBreaking bool
+	// BreakingMarkers replace markers of breaking changes of the changelog (BreakingMarkers by default)
+	BreakingMarkers []string
+	// LinkTemplates turns bare references in entries into markdown links (see Linkify)
+	LinkTemplates map[RefKind]string
+	// RefPatterns replace patterns of references of the changelog (RefPatterns by default)
+	RefPatterns []RefPattern
+	// Locale replaces headings of kinds by localized names (see KindNames)
+	Locale string
+
+	// headings are original headings of kinds of the version
+	headings map[ChangesKind]string
+	// order is the order of kinds of the version in the source document
+	order []ChangesKind
+}
+
+// getKindTitle returns the localized heading, the original heading or the canonical name of the kind
+func (o RenderOptions) getKindTitle(kind ChangesKind) string {
+	if _, ok := KindNames[o.Locale][kind]; !ok {
+		if heading, ok := o.headings[kind]; ok {
+			return heading
+		}
+	}
+
+	return GetKindTitle(kind, o.Locale)
+}
+
+// getKinds returns non-empty kinds of changes in order of the source document, other kinds follow them
+func (o RenderOptions) getKinds(c Changes) []ChangesKind {
+	kinds := make([]ChangesKind, 0, len(c))
+	seen := make(map[ChangesKind]struct{}, len(c))
+
+	for _, kind := range append(append([]ChangesKind(nil), o.order...), c.GetKinds()...) {
+		if _, ok := seen[kind]; ok || !c.Has(kind) {
+			continue
+		}
+
+		seen[kind] = struct{}{}
+		kinds = append(kinds, kind)
+	}
+
+	return kinds
+}
+
+func (l *Changelog) Render(opts RenderOptions) string {
+	if opts.BreakingMarkers == nil {
+		opts.BreakingMarkers = l.breakingMarkers
+	}
+	if opts.RefPatterns == nil {
+		opts.RefPatterns = l.refPatterns
+	}
+
+	output := l.Header + "\n\n"
+	if l.Description != "" {
+		output += l.Description + "\n\n"
+	}
+
+	for _, ver := range l.GetSortedVersions() {
+		if conflict, ok := l.conflicts[ver.GetVersion()]; ok {
+			output += conflict.Render(opts) + "\n\n"
+			continue
+		}
+
+		output += l.Versions[ver.GetVersion()].Render(opts) + "\n\n"
+	}
+
+	output += l.renderLinks()
+
+	return strings.TrimSpace(output)
+}
+
+func (c VersionChanges) Render(opts RenderOptions) string {
+	output := fmt.Sprintf("## %s\n\n", c.Version.GetHeading())
+
+	if c.Description != "" {
+		output += Linkify(c.Description, opts.LinkTemplates, opts.RefPatterns...) + "\n\n"
+	}
+
+	opts.headings = c.Headings
+	opts.order = c.Order
+	output += c.Changes.Render(opts)
+
+	return strings.TrimSpace(output)
+}
+
+func (c Changes) Render(opts RenderOptions) string {
+	output := ""
+
+	if opts.GroupBreaking {
+		var breaking []string
+		breaking, c = c.SplitBreaking(opts.BreakingMarkers...)
+
+		if len(breaking) > 0 {
+			output += fmt.Sprintf("### %s\n", GetKindTitle(BreakingChangesTitle, opts.Locale))
+			for _, entry := range breaking {
+				output += fmt.Sprintf("- %s\n", Linkify(entry, opts.LinkTemplates, opts.RefPatterns...))
+			}
+			output += "\n"
+		}
+	}
+
+	for _, kind := range opts.getKinds(c) {
+		output += fmt.Sprintf("### %s\n", opts.getKindTitle(kind))
+		output += fmt.Sprintf("%s\n\n", Linkify(c.Get(kind), opts.LinkTemplates, opts.RefPatterns...))
+	}
+
+	return strings.TrimSpace(output)
+}
@@ -0,0 +1,174 @@
+package changelog
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	PeriodMonth   StatsPeriod = "month"
+	PeriodQuarter StatsPeriod = "quarter"
+	PeriodYear    StatsPeriod = "year"
+)
+
+// StatsPeriod is the length of periods for counting releases
+type StatsPeriod string
+
+// Stats is the report about release cadence and change composition
+type Stats struct {
+	Releases                  int                     `json:"releases"`
+	ReleasesPerPeriod         []PeriodStats           `json:"releases_per_period"`
+	MedianDaysBetweenReleases float64                 `json:"median_days_between_releases"`
+	LongestGap                *ReleasesGap            `json:"longest_gap,omitempty"`
+	Bumps                     map[ChangesMajority]int `json:"bumps"`
+	Versions                  []VersionStats          `json:"versions"`
+}
+
+// PeriodStats is the number of releases in the period (e.g. "2024-01", "2024-Q1" or "2024")
+type PeriodStats struct {
+	Period   string `json:"period"`
+	Releases int    `json:"releases"`
+}
+
+// ReleasesGap is the number of days between two consequent releases
+type ReleasesGap struct {
+	From VersionString `json:"from"`
+	To   VersionString `json:"to"`
+	Days int           `json:"days"`
+}
+
+// VersionStats is the number of entries per kind of changes in the version
+type VersionStats struct {
+	Version VersionString       `json:"version"`
+	Date    *time.Time          `json:"date,omitempty"`
+	Bump    ChangesMajority     `json:"bump"`
+	Entries map[ChangesKind]int `json:"entries"`
+}
+
+// PeriodOf returns the name of the period containing the date
+func (p StatsPeriod) PeriodOf(date time.Time) string {
+	switch p {
+	case PeriodMonth:
+		return date.Format("2006-01")
+	case PeriodYear:
+		return date.Format("2006")
+	default:
+		return fmt.Sprintf("%d-Q%d", date.Year(), (int(date.Month())-1)/3+1)
+	}
+}
+
+// GetStats returns statistics of released versions matching the filter
+func (l *Changelog) GetStats(filter VersionFilter, period StatsPeriod) Stats {
+	stats := Stats{
+		Bumps: make(map[ChangesMajority]int),
+	}
+
+	var dates []time.Time
+	var dated []Version
+	periods := make(map[string]int)
+
+	for _, ver := range l.GetFilteredVersions(filter) {
+		if ver.IsUnrealized() {
+			continue
+		}
+
+		stats.Releases++
+
+		changes, _ := l.GetChanges(ver)
+		versionStats := VersionStats{
+			Version: ver.GetVersion(),
+			Bump:    l.getBump(ver),
+			Entries: make(map[ChangesKind]int),
+		}
+		for _, kind := range changes.GetKinds() {
+			versionStats.Entries[kind] = len(changes.GetEntries(kind))
+		}
+
+		stats.Bumps[versionStats.Bump]++
+
+		if date := ver.GetDate(); !date.IsZero() {
+			versionStats.Date = &date
+			dates = append(dates, date)
+			dated = append(dated, ver)
+			periods[period.PeriodOf(date)]++
+		}
+
+		stats.Versions = append(stats.Versions, versionStats)
+	}
+
+	for name, releases := range periods {
+		stats.ReleasesPerPeriod = append(stats.ReleasesPerPeriod, PeriodStats{Period: name, Releases: releases})
+	}
+	sort.Slice(stats.ReleasesPerPeriod, func(i, j int) bool {
+		return stats.ReleasesPerPeriod[i].Period < stats.ReleasesPerPeriod[j].Period
+	})
+
+	sort.Slice(dated, func(i, j int) bool {
+		if dated[i].GetDate().Equal(dated[j].GetDate()) {
+			return dated[i].LessThen(dated[j])
+		}
+
+		return dated[i].GetDate().Before(dated[j].GetDate())
+	})
+
+	var gaps []int
+	for i := 1; i < len(dated); i++ {
+		gap := ReleasesGap{
+			From: dated[i-1].GetVersion(),
+			To:   dated[i].GetVersion(),
+			Days: int(dated[i].GetDate().Sub(dated[i-1].GetDate()) / day),
+		}
+		gaps = append(gaps, gap.Days)
+
+		if stats.LongestGap == nil || gap.Days > stats.LongestGap.Days {
+			stats.LongestGap = &gap
+		}
+	}
+
+	stats.MedianDaysBetweenReleases = median(gaps)
+
+	return stats
+}
+
+// getBump returns kind of the bump from the previous released version
+func (l *Changelog) getBump(ver Version) ChangesMajority {
+	if !ver.IsCommon() {
+		return NoChanges
+	}
+
+	var previous *Version
+	for _, v := range l.GetSortedVersions() {
+		if v.IsCommon() && v.LessThen(ver) {
+			previous = &v
+			break
+		}
+	}
+
+	switch {
+	case previous == nil:
+		return NoChanges
+	case ver.parsedVersion.Major() != previous.parsedVersion.Major():
+		return MajorChanges
+	case ver.parsedVersion.Minor() != previous.parsedVersion.Minor():
+		return MinorChanges
+	default:
+		return PatchChanges
+	}
+}
+
+func median(values []int) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := append([]int(nil), values...)
+	sort.Ints(sorted)
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return float64(sorted[middle])
+	}
+
+	return float64(sorted[middle-1]+sorted[middle]) / 2
+}
@@ -0,0 +1,38 @@
+package changelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestChangelog_GetStats(t *testing.T) {
+	cl := NewChangelog("", "", map[VersionString]VersionChanges{})
+	for ver, date := range map[VersionString]string{"1.0.0": "2024-01-01", "1.0.1": "2024-01-11", "1.1.0": "2024-02-10", "2.0.0": "2024-04-10"} {
+		parsed, _ := time.Parse("2006-01-02", date)
+		changes := NewChanges()
+		changes.SetEntries(Fixed, []string{"fix 1", "fix 2"})
+		_ = cl.Add(RequireVersionFromString(ver, &parsed), changes)
+	}
+	_ = cl.Add(Unreleased, NewChanges())
+
+	convey.Convey("statistics of the changelog", t, func() {
+		stats := cl.GetStats(VersionFilter{}, PeriodQuarter)
+
+		convey.So(stats.Releases, convey.ShouldEqual, 4)
+		convey.So(stats.ReleasesPerPeriod, convey.ShouldResemble, []PeriodStats{{"2024-Q1", 3}, {"2024-Q2", 1}})
+		convey.So(stats.MedianDaysBetweenReleases, convey.ShouldEqual, 30)
+		convey.So(*stats.LongestGap, convey.ShouldResemble, ReleasesGap{From: "1.1.0", To: "2.0.0", Days: 60})
+		convey.So(stats.Bumps, convey.ShouldResemble, map[ChangesMajority]int{NoChanges: 1, PatchChanges: 1, MinorChanges: 1, MajorChanges: 1})
+		convey.So(stats.Versions[0].Entries[Fixed], convey.ShouldEqual, 2)
+
+		convey.Convey("should be filtered by dates", func() {
+			since, _ := time.Parse("2006-01-02", "2024-01-05")
+
+			stats := cl.GetStats(VersionFilter{Since: since, To: RequireVersionFromString("1.1.0", nil)}, PeriodYear)
+			convey.So(stats.Releases, convey.ShouldEqual, 2)
+			convey.So(stats.ReleasesPerPeriod, convey.ShouldResemble, []PeriodStats{{"2024", 2}})
+		})
+	})
+}
@@ -25,7 +25,7 @@ var (
 	ErrNotIsVersion = errors.New("the node is not version")
 )
 
-var re = regexp.MustCompile(`^(\[(.+?)]|(.+?))(\s-\s(\d{4}-\d{2}-\d{2}))?$`)
+var re = regexp.MustCompile(`^(\[(.+?)]|(.+?))(\s-\s(\d{4}-\d{2}-\d{2}))?(\s+\[(?i:yanked)])?$`)
 
 type VersionString string
 
@@ -33,6 +33,7 @@ type Version struct {
 	version       VersionString
 	parsedVersion *semver.Version
 	date          time.Time
+	yanked        bool
 }
 
 func NewVersion(version VersionString, date *time.Time) (Version, error) {
@@ -43,7 +44,7 @@ func NewVersion(version VersionString, date *time.Time) (Version, error) {
 	if ver.IsCommon() {
 		parsed, err := semver.NewVersion(string(ver.version))
 		if err != nil {
-			return Empty, fmt.Errorf("%v: %v", ErrNotIsVersion, err)
+			return Empty, fmt.Errorf("%w: %v", ErrNotIsVersion, err)
 		}
 		ver.version = VersionString(parsed.String())
 		ver.parsedVersion = parsed
@@ -70,6 +71,7 @@ func RequireVersionFromString(version VersionString, date *time.Time) Version {
 // - [version]
 // - version - 2000-01-01
 // - version
+// - [version] - 2000-01-01 [YANKED]
 //
 // The version should be supported by semver or be constant "Unrealized"
 func NewVersionFromNode(src []byte, node ast.Node, requiredLevel int) (Version, error) {
@@ -94,12 +96,21 @@ func NewVersionFromNode(src []byte, node ast.Node, requiredLevel int) (Version,
 		ver = matches[0][3]
 	}
 
-	date, err := time.Parse("2006-01-02", matches[0][5])
-	if err == nil {
-		return NewVersion(VersionString(ver), &date)
+	var parsed Version
+	var err error
+
+	date, dateErr := time.Parse("2006-01-02", matches[0][5])
+	if dateErr == nil {
+		parsed, err = NewVersion(VersionString(ver), &date)
+	} else {
+		parsed, err = NewVersion(VersionString(ver), nil)
+	}
+
+	if err != nil {
+		return Empty, err
 	}
 
-	return NewVersion(VersionString(ver), nil)
+	return parsed.WithYanked(matches[0][6] != ""), nil
 }
 
 func (v Version) IsValid() bool {
@@ -126,6 +137,44 @@ func (v Version) GetDate() time.Time {
 	return v.date
 }
 
+// WithDate returns copy of the version with changed date
+func (v Version) WithDate(date time.Time) Version {
+	v.date = date.Truncate(day)
+
+	return v
+}
+
+// IsPrerelease reports whether the version has pre-release part (e.g. 1.0.0-beta.1)
+func (v Version) IsPrerelease() bool {
+	return v.IsCommon() && v.parsedVersion.Prerelease() != ""
+}
+
+// IsYanked reports whether the version was marked as [YANKED]
+func (v Version) IsYanked() bool {
+	return v.yanked
+}
+
+// WithYanked returns copy of the version with changed [YANKED] marker
+func (v Version) WithYanked(yanked bool) Version {
+	v.yanked = yanked
+
+	return v
+}
+
+// GetHeading returns the markdown heading text of the version (without leading hashes)
+func (v Version) GetHeading() string {
+	if v.IsUnrealized() {
+		return fmt.Sprintf("[%s]", v.GetVersion())
+	}
+
+	heading := fmt.Sprintf("[%s] - %s", v.GetVersion(), v.GetDate().Format("2006-01-02"))
+	if v.IsYanked() {
+		heading += " [YANKED]"
+	}
+
+	return heading
+}
+
 func (v Version) LessThen(ver Version) bool {
 	isVUnrealized := v.IsUnrealized()
 	isVerUnrealized := ver.IsUnrealized()
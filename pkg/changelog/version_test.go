@@ -16,6 +16,7 @@ func TestNewFromNode(t *testing.T) {
 		{"## 1.0.2-patch2", "1.0.2-patch2", "0001-01-01"},
 		{"## Unreleased", "Unreleased", "0001-01-01"},
 		{"## Latest", "Latest", "0001-01-01"},
+		{"## [0.0.5] - 2014-12-13 [YANKED]", "0.0.5", "2014-12-13"},
 	}
 
 	for _, v := range versions {
@@ -34,3 +35,25 @@ func TestNewFromNode(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFromNode_Yanked(t *testing.T) {
+	versions := map[string]bool{
+		"## [0.0.5] - 2014-12-13 [YANKED]": true,
+		"## [0.0.5] - 2014-12-13 [yanked]": true,
+		"## [0.0.5] - 2014-12-13":          false,
+	}
+
+	for heading, yanked := range versions {
+		src := []byte(heading)
+
+		node := goldmark.DefaultParser().Parse(text.NewReader(src))
+
+		convey.Convey(heading, t, func() {
+			ver, err := NewVersionFromNode(src, node.FirstChild(), 2)
+
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ver.IsYanked(), convey.ShouldEqual, yanked)
+			convey.So("## "+ver.GetHeading(), convey.ShouldStartWith, "## [0.0.5] - 2014-12-13")
+		})
+	}
+}
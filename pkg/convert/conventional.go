@@ -0,0 +1,93 @@
+package convert
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"time"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+var (
+	// reConventionalVersion matches headings of versions: "# [1.2.0](https://.../compare/v1.1.0...v1.2.0) (2024-01-01)",
+	// "## 1.0.1 (2023-11-01)" or "## <small>1.0.1 (2023-11-01)</small>"
+	reConventionalVersion = regexp.MustCompile(`^#{1,3}\s+(?:<small>)?\[?v?(\d+\.\d+\.\d+[^\]\s()]*)]?(?:\(([^)]*)\))?\s*(?:\((\d{4}-\d{2}-\d{2})\))?`)
+	reHTMLLine            = regexp.MustCompile(`^\s*<[^>]*>\s*(</[^>]*>)?\s*$`)
+)
+
+// FromConventional reads the changelog generated by conventional-changelog. Compare links of version headings
+// are kept as links of versions, links to commits are removed from entries
+func FromConventional(content []byte, opts Options) (*changelog.Changelog, error) {
+	opts = opts.withDefaults()
+	cl := changelog.NewChangelog("", "", make(map[changelog.VersionString]changelog.VersionChanges))
+
+	var ver changelog.Version
+	var reader *sectionsReader
+
+	flush := func() error {
+		if reader == nil {
+			return nil
+		}
+
+		return addVersion(cl, ver, reader.Changes())
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if match := reConventionalVersion.FindStringSubmatch(line); match != nil {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+
+			var date *time.Time
+			if parsed, err := time.Parse("2006-01-02", match[3]); err == nil {
+				date = &parsed
+			}
+
+			v, err := changelog.NewVersion(changelog.VersionString(match[1]), date)
+			if err != nil {
+				return nil, err
+			}
+
+			ver, reader = v, newSectionsReader(changelog.Changed, opts)
+			if match[2] != "" {
+				cl.Links[string(ver.GetVersion())] = match[2]
+			}
+			continue
+		}
+
+		// Anchors (e.g. `<a name="1.0.0"></a>`) and content before the first version
+		if reader == nil || reHTMLLine.MatchString(line) {
+			continue
+		}
+
+		reader.ReadLine(line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return cl, nil
+}
+
+// addVersion adds the version to the changelog. Entries of repeated versions are merged
+func addVersion(cl *changelog.Changelog, ver changelog.Version, changes changelog.Changes) error {
+	existing, ok := cl.GetChanges(ver)
+	if !ok {
+		return cl.Add(ver, changes)
+	}
+
+	for _, kind := range changes.GetKinds() {
+		existing.SetEntries(kind, append(existing.GetEntries(kind), changes.GetEntries(kind)...))
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package convert
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+const conventionalChangelog = `# Changelog
+
+All notable changes to this project will be documented in this file.
+
+## [1.2.0](https://github.com/org/repo/compare/v1.1.0...v1.2.0) (2024-02-01)
+
+
+### ⚠ BREAKING CHANGES
+
+* **api:** drop legacy endpoint
+
+### Features
+
+* **api:** add endpoint ([abc1234](https://github.com/org/repo/commit/abc1234def)), closes [#12](https://github.com/org/repo/issues/12)
+* **cli:**
+  * add flag ([1111111](https://github.com/org/repo/commit/1111111))
+  * add another flag
+
+### Bug Fixes
+
+* crash on start
+  with details
+
+<a name="1.1.0"></a>
+## 1.1.0 (2024-01-01)
+
+### Performance Improvements
+
+* faster parsing
+`
+
+func TestFromConventional(t *testing.T) {
+	convey.Convey("converting conventional changelog", t, func() {
+		cl, err := Convert(FormatConventional, []byte(conventionalChangelog), Options{})
+		convey.So(err, convey.ShouldBeNil)
+
+		convey.So(cl.GetSortedVersions(), convey.ShouldHaveLength, 2)
+
+		convey.Convey("should map sections to kinds", func() {
+			changes, ok := cl.GetChanges(changelog.RequireVersionFromString("1.2.0", nil))
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(changes.GetEntries(changelog.Added), convey.ShouldResemble, []string{
+				"**api:** add endpoint, closes [#12](https://github.com/org/repo/issues/12)",
+				"**cli:** add flag",
+				"**cli:** add another flag",
+			})
+			convey.So(changes.GetEntries(changelog.Fixed), convey.ShouldResemble, []string{"crash on start with details"})
+			convey.So(changes.GetEntries(changelog.Changed), convey.ShouldResemble, []string{"BREAKING: **api:** drop legacy endpoint"})
+			convey.So(changes.GetMajority(), convey.ShouldEqual, changelog.MajorChanges)
+
+			changes, _ = cl.GetChanges(changelog.RequireVersionFromString("1.1.0", nil))
+			convey.So(changes.GetEntries(changelog.Changed), convey.ShouldResemble, []string{"faster parsing"})
+		})
+
+		convey.Convey("should keep dates and compare links", func() {
+			convey.So(cl.Versions["1.2.0"].Version.GetDate().Format("2006-01-02"), convey.ShouldEqual, "2024-02-01")
+			convey.So(cl.Links, convey.ShouldResemble, map[string]string{"1.2.0": "https://github.com/org/repo/compare/v1.1.0...v1.2.0"})
+		})
+	})
+
+	convey.Convey("converting conventional changelog with options", t, func() {
+		cl, err := Convert(FormatConventional, []byte(conventionalChangelog), Options{
+			BreakingMarker: "BC:",
+			KindAliases:    map[string]changelog.ChangesKind{"Performance Improvements": "Performance"},
+		})
+		convey.So(err, convey.ShouldBeNil)
+
+		changes, _ := cl.GetChanges(changelog.RequireVersionFromString("1.2.0", nil))
+		convey.So(changes.GetEntries(changelog.Changed), convey.ShouldResemble, []string{"BC: **api:** drop legacy endpoint"})
+
+		changes, _ = cl.GetChanges(changelog.RequireVersionFromString("1.1.0", nil))
+		convey.So(changes.GetEntries("Performance"), convey.ShouldResemble, []string{"faster parsing"})
+	})
+
+	convey.Convey("converting changelog without versions", t, func() {
+		_, err := Convert(FormatConventional, []byte("# Changelog\n"), Options{})
+		convey.So(err, convey.ShouldEqual, ErrNoVersions)
+	})
+}
@@ -0,0 +1,78 @@
+// Package convert imports changelogs of other formats into Keep a Changelog structure
+package convert
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+const (
+	// FormatConventional is the markdown generated by conventional-changelog
+	FormatConventional Format = "conventional"
+	// FormatGitHubReleases is the JSON export of GitHub Releases (e.g. `gh api repos/{owner}/{repo}/releases`)
+	FormatGitHubReleases Format = "github-releases"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown format")
+	ErrNoVersions    = errors.New("no versions found")
+)
+
+// Format is the format of the source changelog
+type Format string
+
+// Options configure converting of changelogs
+type Options struct {
+	// BreakingMarker is prepended to entries of breaking changes (the first of changelog.BreakingMarkers by default)
+	BreakingMarker string
+	// KindAliases are added to default aliases of headings of sections (see changelog.NewKindResolver)
+	KindAliases map[string]changelog.ChangesKind
+
+	kinds changelog.KindResolver
+}
+
+func (o Options) withDefaults() Options {
+	o.kinds = changelog.NewKindResolver(changelog.KindAliases, defaultKindAliases, o.KindAliases)
+
+	if o.BreakingMarker == "" && len(changelog.BreakingMarkers) > 0 {
+		o.BreakingMarker = changelog.BreakingMarkers[0]
+	}
+
+	return o
+}
+
+func NewFormat(format string) (Format, error) {
+	switch Format(format) {
+	case FormatConventional, FormatGitHubReleases:
+		return Format(format), nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+}
+
+// Convert reads the changelog of the format. Header and description of the result are empty
+func Convert(format Format, content []byte, opts Options) (*changelog.Changelog, error) {
+	var cl *changelog.Changelog
+	var err error
+
+	switch format {
+	case FormatConventional:
+		cl, err = FromConventional(content, opts)
+	case FormatGitHubReleases:
+		cl, err = FromGitHubReleases(content, opts)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cl.Versions) == 0 {
+		return nil, ErrNoVersions
+	}
+
+	return cl, nil
+}
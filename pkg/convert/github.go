@@ -0,0 +1,89 @@
+package convert
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+// reFullChangelog matches the compare link of GitHub release notes: "**Full Changelog**: https://..."
+var reFullChangelog = regexp.MustCompile(`^\s*\*\*Full Changelog\*\*:\s*(\S+)\s*$`)
+
+// GitHubRelease is the release of GitHub Releases API. Fields of `gh release list --json` are supported as well
+type GitHubRelease struct {
+	TagName     string     `json:"tag_name"`
+	Tag         string     `json:"tagName"`
+	Name        string     `json:"name"`
+	Body        string     `json:"body"`
+	Draft       bool       `json:"draft"`
+	IsDraft     bool       `json:"isDraft"`
+	PublishedAt *time.Time `json:"published_at"`
+	Published   *time.Time `json:"publishedAt"`
+}
+
+func (r GitHubRelease) getTag() string {
+	if r.TagName != "" {
+		return r.TagName
+	}
+
+	return r.Tag
+}
+
+func (r GitHubRelease) getDate() *time.Time {
+	if r.PublishedAt != nil {
+		return r.PublishedAt
+	}
+
+	return r.Published
+}
+
+// FromGitHubReleases reads the JSON array of GitHub releases. Drafts are skipped, entries without
+// section heading are added to Changed
+func FromGitHubReleases(content []byte, opts Options) (*changelog.Changelog, error) {
+	opts = opts.withDefaults()
+
+	var releases []GitHubRelease
+	if err := json.Unmarshal(content, &releases); err != nil {
+		return nil, fmt.Errorf("unable to decode releases: %w", err)
+	}
+
+	cl := changelog.NewChangelog("", "", make(map[changelog.VersionString]changelog.VersionChanges))
+
+	for _, release := range releases {
+		if release.Draft || release.IsDraft {
+			continue
+		}
+
+		ver, err := changelog.NewVersion(changelog.VersionString(strings.TrimPrefix(release.getTag(), "v")), release.getDate())
+		if err != nil {
+			return nil, fmt.Errorf("release %s: %w", release.getTag(), err)
+		}
+
+		reader := newSectionsReader(changelog.Changed, opts)
+
+		scanner := bufio.NewScanner(strings.NewReader(release.Body))
+		for scanner.Scan() {
+			if match := reFullChangelog.FindStringSubmatch(scanner.Text()); match != nil {
+				cl.Links[string(ver.GetVersion())] = match[1]
+				continue
+			}
+
+			reader.ReadLine(scanner.Text())
+		}
+
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+
+		if err := addVersion(cl, ver, reader.Changes()); err != nil {
+			return nil, err
+		}
+	}
+
+	return cl, nil
+}
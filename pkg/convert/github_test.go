@@ -0,0 +1,47 @@
+package convert
+
+import (
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+const gitHubReleases = `[
+  {"tag_name": "v1.3.0", "draft": true, "body": "* wip"},
+  {
+    "tag_name": "v1.2.0",
+    "published_at": "2024-02-01T10:00:00Z",
+    "body": "## What's Changed\r\n* add x by @alice in https://github.com/org/repo/pull/12\r\n\r\n## New Contributors\r\n* @alice made their first contribution\r\n\r\n**Full Changelog**: https://github.com/org/repo/compare/v1.1.0...v1.2.0"
+  },
+  {"tagName": "v1.1.0", "publishedAt": "2024-01-01T10:00:00Z", "body": "### Fixed\n- crash\n\n### Security\n- bump deps"}
+]`
+
+func TestFromGitHubReleases(t *testing.T) {
+	convey.Convey("converting GitHub releases", t, func() {
+		cl, err := Convert(FormatGitHubReleases, []byte(gitHubReleases), Options{})
+		convey.So(err, convey.ShouldBeNil)
+
+		convey.Convey("should skip drafts", func() {
+			convey.So(cl.GetLatestVersion().GetVersion(), convey.ShouldEqual, "1.2.0")
+		})
+
+		convey.Convey("should read release notes", func() {
+			changes, _ := cl.GetChanges(changelog.RequireVersionFromString("1.2.0", nil))
+			convey.So(changes.GetKinds(), convey.ShouldResemble, []changelog.ChangesKind{changelog.Changed})
+			convey.So(changes.GetEntries(changelog.Changed), convey.ShouldResemble, []string{"add x by @alice in https://github.com/org/repo/pull/12"})
+			convey.So(cl.Links["1.2.0"], convey.ShouldEqual, "https://github.com/org/repo/compare/v1.1.0...v1.2.0")
+
+			changes, _ = cl.GetChanges(changelog.RequireVersionFromString("1.1.0", nil))
+			convey.So(changes.GetEntries(changelog.Fixed), convey.ShouldResemble, []string{"crash"})
+			convey.So(changes.GetEntries(changelog.Security), convey.ShouldResemble, []string{"bump deps"})
+			convey.So(cl.Versions["1.1.0"].Version.GetDate().Format("2006-01-02"), convey.ShouldEqual, "2024-01-01")
+		})
+	})
+
+	convey.Convey("converting invalid JSON", t, func() {
+		_, err := Convert(FormatGitHubReleases, []byte("{"), Options{})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,130 @@
+package convert
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+const (
+	// skippedKind is the kind of sections which are not changes (e.g. "New Contributors" of GitHub release notes)
+	skippedKind changelog.ChangesKind = ""
+	// breakingKind is the kind of sections of breaking changes. Their entries are added to Changed with the breaking marker
+	breakingKind changelog.ChangesKind = "\x00breaking"
+)
+
+// defaultKindAliases are aliases of headings of sections of conventional-changelog and GitHub release notes.
+// They are added to changelog.KindAliases for resolving headings of converted changelogs
+var defaultKindAliases = map[string]changelog.ChangesKind{
+	"what's changed":   changelog.Changed,
+	"reverts":          changelog.Changed,
+	"code refactoring": changelog.Changed,
+	"breaking changes": breakingKind,
+	"new contributors": skippedKind,
+}
+
+var (
+	reHeading    = regexp.MustCompile(`^#{1,6}\s+(.+?)\s*#*$`)
+	reBullet     = regexp.MustCompile(`^(\s*)[*+-]\s+(.*)$`)
+	reScope      = regexp.MustCompile(`^\*\*[^*]+:\*\*$`)
+	reCommitLink = regexp.MustCompile(`\s*\(\[[0-9a-f]{7,40}]\([^)]*\)\)`)
+)
+
+// sectionsReader collects entries of markdown sections (a heading followed by a list) of one version
+type sectionsReader struct {
+	kind     changelog.ChangesKind
+	breaking bool
+	scope    string
+	entries  map[changelog.ChangesKind][]string
+	kinds    []changelog.ChangesKind
+	opts     Options
+}
+
+// newSectionsReader returns the reader which adds entries before the first heading to the default kind
+func newSectionsReader(defaultKind changelog.ChangesKind, opts Options) *sectionsReader {
+	return &sectionsReader{
+		kind:    defaultKind,
+		entries: make(map[changelog.ChangesKind][]string),
+		opts:    opts,
+	}
+}
+
+// ReadLine reads the line of the version body
+func (r *sectionsReader) ReadLine(line string) {
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+
+	if match := reHeading.FindStringSubmatch(line); match != nil {
+		r.kind, r.breaking = r.opts.kinds.Resolve(match[1]), false
+		if r.kind == breakingKind {
+			r.kind, r.breaking = changelog.Changed, true
+		}
+		r.scope = ""
+		return
+	}
+
+	if r.kind == skippedKind {
+		return
+	}
+
+	if match := reBullet.FindStringSubmatch(line); match != nil {
+		text := cleanEntry(match[2])
+
+		// Nested entries of the scope: "* **scope:**" followed by indented "  * entry"
+		if match[1] != "" && r.scope != "" {
+			r.addEntry(r.scope + " " + text)
+			return
+		}
+
+		r.scope = ""
+		if reScope.MatchString(text) {
+			r.scope = text
+			return
+		}
+
+		r.addEntry(text)
+		return
+	}
+
+	// Continuation of the previous entry or the paragraph
+	text := cleanEntry(line)
+	if entries := r.entries[r.kind]; len(entries) > 0 {
+		entries[len(entries)-1] += " " + text
+		return
+	}
+
+	r.addEntry(text)
+}
+
+func (r *sectionsReader) addEntry(text string) {
+	if text == "" {
+		return
+	}
+
+	if r.breaking && r.opts.BreakingMarker != "" {
+		text = r.opts.BreakingMarker + " " + text
+	}
+
+	if _, ok := r.entries[r.kind]; !ok {
+		r.kinds = append(r.kinds, r.kind)
+	}
+
+	r.entries[r.kind] = append(r.entries[r.kind], text)
+}
+
+// Changes returns collected entries
+func (r *sectionsReader) Changes() changelog.Changes {
+	changes := changelog.NewChanges()
+	for _, kind := range r.kinds {
+		changes.SetEntries(kind, r.entries[kind])
+	}
+
+	return changes
+}
+
+// cleanEntry removes links to commits (e.g. "([1a2b3c4](https://github.com/org/repo/commit/1a2b3c4...))")
+func cleanEntry(text string) string {
+	return strings.TrimSpace(reCommitLink.ReplaceAllString(text, ""))
+}
@@ -0,0 +1,186 @@
+// Package debian renders versions of the changelog as debian/changelog stanzas
+package debian
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+const (
+	UrgencyLow       Urgency = "low"
+	UrgencyMedium    Urgency = "medium"
+	UrgencyHigh      Urgency = "high"
+	UrgencyEmergency Urgency = "emergency"
+	UrgencyCritical  Urgency = "critical"
+)
+
+const (
+	// lineWidth is the maximal width of lines of entries recommended by Debian policy
+	lineWidth = 80
+	// dateLayout is RFC 2822 date required by Debian policy
+	dateLayout = time.RFC1123Z
+)
+
+// DefaultUrgencies are urgencies of kinds of changes. Urgency of the version is the highest urgency of its kinds
+var DefaultUrgencies = map[changelog.ChangesKind]Urgency{
+	changelog.Security: UrgencyHigh,
+}
+
+var (
+	ErrPackageRequired    = errors.New("package name is required")
+	ErrMaintainerRequired = errors.New("maintainer is required")
+	ErrDateRequired       = errors.New("release date is required")
+	ErrInvalidUrgency     = errors.New("invalid urgency")
+)
+
+var urgencyLevels = map[Urgency]int{
+	UrgencyLow:       1,
+	UrgencyMedium:    2,
+	UrgencyHigh:      3,
+	UrgencyEmergency: 4,
+	UrgencyCritical:  5,
+}
+
+// Urgency is the urgency of the upload
+type Urgency string
+
+func NewUrgency(urgency string) (Urgency, error) {
+	if _, ok := urgencyLevels[Urgency(urgency)]; !ok {
+		return "", fmt.Errorf("%w: %s", ErrInvalidUrgency, urgency)
+	}
+
+	return Urgency(urgency), nil
+}
+
+// Options are parameters of stanzas
+type Options struct {
+	// Package is the name of the source package
+	Package string
+	// Distribution is the target distribution ("unstable" by default)
+	Distribution string
+	// Revision is the Debian revision of the package ("1" by default)
+	Revision string
+	// Maintainer is the name and the email of the maintainer ("Name <email>")
+	Maintainer string
+	// Urgencies are urgencies of kinds of changes (DefaultUrgencies by default)
+	Urgencies map[changelog.ChangesKind]Urgency
+	// DefaultUrgency is the urgency of kinds missing in Urgencies ("medium" by default)
+	DefaultUrgency Urgency
+}
+
+func (o Options) withDefaults() Options {
+	if o.Distribution == "" {
+		o.Distribution = "unstable"
+	}
+
+	if o.Revision == "" {
+		o.Revision = "1"
+	}
+
+	if o.Urgencies == nil {
+		o.Urgencies = DefaultUrgencies
+	}
+
+	if o.DefaultUrgency == "" {
+		o.DefaultUrgency = UrgencyMedium
+	}
+
+	return o
+}
+
+// Render renders released versions as stanzas in the order of sections. Unreleased changes are skipped,
+// released versions without date make rendering fail with ErrDateRequired
+func Render(sections []changelog.VersionChanges, opts Options) (string, error) {
+	if opts.Package == "" {
+		return "", ErrPackageRequired
+	}
+
+	if opts.Maintainer == "" {
+		return "", ErrMaintainerRequired
+	}
+
+	opts = opts.withDefaults()
+
+	stanzas := make([]string, 0, len(sections))
+	for _, section := range sections {
+		if !section.Version.IsCommon() {
+			continue
+		}
+
+		if section.Version.GetDate().IsZero() {
+			return "", fmt.Errorf("%w: %s", ErrDateRequired, section.Version.GetVersion())
+		}
+
+		stanzas = append(stanzas, renderStanza(section, opts))
+	}
+
+	return strings.Join(stanzas, "\n"), nil
+}
+
+func renderStanza(section changelog.VersionChanges, opts Options) string {
+	output := fmt.Sprintf("%s (%s) %s; urgency=%s\n\n", opts.Package, GetVersion(section.Version, opts.Revision), opts.Distribution, getUrgency(section.Changes, opts))
+
+	for _, kind := range section.Changes.GetKinds() {
+		for _, entry := range section.Changes.GetEntries(kind) {
+			output += wrapEntry(entry)
+		}
+	}
+
+	output += fmt.Sprintf("\n -- %s  %s\n", opts.Maintainer, section.Version.GetDate().Format(dateLayout))
+
+	return output
+}
+
+// GetVersion returns the Debian version: pre-releases are sorted before releases with "~" (1.0.0-rc.1 → 1.0.0~rc.1)
+func GetVersion(ver changelog.Version, revision string) string {
+	version := strings.Replace(string(ver.GetVersion()), "-", "~", 1)
+	if revision == "" {
+		return version
+	}
+
+	return version + "-" + revision
+}
+
+// getUrgency returns the highest urgency of kinds of changes
+func getUrgency(changes changelog.Changes, opts Options) Urgency {
+	urgency := UrgencyLow
+	for _, kind := range changes.GetKinds() {
+		kindUrgency, ok := opts.Urgencies[kind]
+		if !ok {
+			kindUrgency = opts.DefaultUrgency
+		}
+
+		if urgencyLevels[kindUrgency] > urgencyLevels[urgency] {
+			urgency = kindUrgency
+		}
+	}
+
+	if len(changes.GetKinds()) == 0 {
+		return opts.DefaultUrgency
+	}
+
+	return urgency
+}
+
+// wrapEntry renders the entry as the bullet ("  * ") wrapped by lineWidth with indented continuation lines
+func wrapEntry(entry string) string {
+	output := "  *"
+	width := len(output)
+
+	for _, word := range strings.Fields(entry) {
+		if width+1+utf8.RuneCountInString(word) > lineWidth && width > len("  *") {
+			output += "\n   "
+			width = len("   ")
+		}
+
+		output += " " + word
+		width += 1 + utf8.RuneCountInString(word)
+	}
+
+	return output + "\n"
+}
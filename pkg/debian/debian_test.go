@@ -0,0 +1,78 @@
+package debian
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+func TestRender(t *testing.T) {
+	convey.Convey("rendering debian changelog", t, func() {
+		date := time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC)
+
+		security := changelog.NewChanges()
+		security.SetEntries(changelog.Fixed, []string{"fix crash"})
+		security.SetEntries(changelog.Security, []string{"fix injection in the query builder which allowed to read data of other users of the service"})
+
+		fixes := changelog.NewChanges()
+		fixes.SetEntries(changelog.Fixed, []string{"fix typo"})
+
+		sections := []changelog.VersionChanges{
+			changelog.NewVersionChanges(changelog.Unreleased, fixes),
+			changelog.NewVersionChanges(changelog.RequireVersionFromString("1.2.0-rc.1", &date), security),
+			changelog.NewVersionChanges(changelog.RequireVersionFromString("1.1.1", &date), fixes),
+		}
+
+		convey.Convey("should render released versions as stanzas", func() {
+			output, err := Render(sections, Options{Package: "changelog-cli", Maintainer: "Jane Doe <jane@example.com>"})
+
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(output, convey.ShouldEqual, `changelog-cli (1.2.0~rc.1-1) unstable; urgency=high
+
+  * fix injection in the query builder which allowed to read data of other users
+    of the service
+  * fix crash
+
+ -- Jane Doe <jane@example.com>  Mon, 29 Jan 2024 00:00:00 +0000
+
+changelog-cli (1.1.1-1) unstable; urgency=medium
+
+  * fix typo
+
+ -- Jane Doe <jane@example.com>  Mon, 29 Jan 2024 00:00:00 +0000
+`)
+		})
+
+		convey.Convey("should use configured distribution and urgencies", func() {
+			output, err := Render(sections[2:], Options{
+				Package:      "changelog-cli",
+				Maintainer:   "Jane Doe <jane@example.com>",
+				Distribution: "bookworm",
+				Revision:     "2",
+				Urgencies:    map[changelog.ChangesKind]Urgency{changelog.Fixed: UrgencyLow},
+			})
+
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(output, convey.ShouldStartWith, "changelog-cli (1.1.1-2) bookworm; urgency=low\n")
+		})
+
+		convey.Convey("should require package and maintainer", func() {
+			_, err := Render(sections, Options{Maintainer: "Jane Doe <jane@example.com>"})
+			convey.So(err, convey.ShouldEqual, ErrPackageRequired)
+
+			_, err = Render(sections, Options{Package: "changelog-cli"})
+			convey.So(err, convey.ShouldEqual, ErrMaintainerRequired)
+		})
+
+		convey.Convey("should require dates of released versions", func() {
+			undated := changelog.NewVersionChanges(changelog.RequireVersionFromString("1.3.0", nil), fixes)
+
+			_, err := Render(append(sections, undated), Options{Package: "changelog-cli", Maintainer: "Jane Doe <jane@example.com>"})
+			convey.So(errors.Is(err, ErrDateRequired), convey.ShouldBeTrue)
+		})
+	})
+}
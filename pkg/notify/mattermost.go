@@ -0,0 +1,39 @@
+package notify
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// mattermostMaxText is the maximal length of the post in Mattermost
+const mattermostMaxText = 16383
+
+type mattermostMessage struct {
+	Text string `json:"text"`
+}
+
+func mattermostPayload(msg Message) mattermostMessage {
+	msg = fit(msg, func(m Message) bool {
+		return utf8.RuneCountInString(renderMattermostText(m)) <= mattermostMaxText
+	})
+
+	return mattermostMessage{Text: renderMattermostText(msg)}
+}
+
+// renderMattermostText renders the message in markdown supported by Mattermost
+func renderMattermostText(msg Message) string {
+	lines := []string{"#### " + msg.Title}
+
+	for _, group := range msg.Groups {
+		lines = append(lines, "", "##### "+group.Title)
+		for _, entry := range group.Entries {
+			lines = append(lines, "- "+entry)
+		}
+	}
+
+	if msg.Omitted > 0 {
+		lines = append(lines, "", "_"+omittedNotice(msg)+"_")
+	}
+
+	return strings.Join(lines, "\n")
+}
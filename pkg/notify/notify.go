@@ -0,0 +1,155 @@
+// Package notify converts changes of the changelog into messages of chats (Slack, Mattermost, Microsoft Teams)
+// and posts them by incoming webhooks
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+const (
+	TargetSlack      Target = "slack"
+	TargetMattermost Target = "mattermost"
+	TargetTeams      Target = "teams"
+)
+
+var (
+	ErrUnknownTarget = errors.New("unknown target")
+	ErrEmptyMessage  = errors.New("message has no changes")
+)
+
+// Target is the chat platform receiving messages
+type Target string
+
+func NewTarget(target string) (Target, error) {
+	switch Target(target) {
+	case TargetSlack, TargetMattermost, TargetTeams:
+		return Target(target), nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownTarget, target)
+	}
+}
+
+// Message is the platform independent notification: the title and groups of entries
+type Message struct {
+	Title  string
+	Groups []Group
+	// Omitted is the number of entries dropped to fit limits of the platform
+	Omitted int
+}
+
+// Group is the kind of changes (or the group of breaking changes) with its entries
+type Group struct {
+	Title   string
+	Entries []string
+}
+
+// NewMessage returns the message with changes grouped by kinds. Breaking changes, links of references
+// and localized headings are handled by options like on rendering the changelog
+func NewMessage(title string, changes changelog.Changes, opts changelog.RenderOptions) Message {
+	msg := Message{Title: title}
+
+	if opts.GroupBreaking {
+		var breaking []string
+		breaking, changes = changes.SplitBreaking(opts.BreakingMarkers...)
+
+		if len(breaking) > 0 {
+			msg.Groups = append(msg.Groups, newGroup(changelog.GetKindTitle(changelog.BreakingChangesTitle, opts.Locale), breaking, opts))
+		}
+	}
+
+	for _, kind := range changes.GetKinds() {
+		if entries := changes.GetEntries(kind); len(entries) > 0 {
+			msg.Groups = append(msg.Groups, newGroup(changelog.GetKindTitle(kind, opts.Locale), entries, opts))
+		}
+	}
+
+	return msg
+}
+
+func newGroup(title string, entries []string, opts changelog.RenderOptions) Group {
+	group := Group{Title: title, Entries: make([]string, 0, len(entries))}
+	for _, entry := range entries {
+		group.Entries = append(group.Entries, changelog.Linkify(entry, opts.LinkTemplates, opts.RefPatterns...))
+	}
+
+	return group
+}
+
+// IsEmpty reports whether the message has no entries
+func (m Message) IsEmpty() bool {
+	return len(m.Groups) == 0 && m.Omitted == 0
+}
+
+// Payload returns the body of the webhook request of the platform
+func Payload(target Target, msg Message) (any, error) {
+	if msg.IsEmpty() {
+		return nil, ErrEmptyMessage
+	}
+
+	switch target {
+	case TargetSlack:
+		return slackPayload(msg), nil
+	case TargetMattermost:
+		return mattermostPayload(msg), nil
+	case TargetTeams:
+		return teamsPayload(msg), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTarget, target)
+	}
+}
+
+// fit drops the last entries of the message until it fits limits of the platform
+func fit(msg Message, fits func(Message) bool) Message {
+	total := 0
+	for _, group := range msg.Groups {
+		total += len(group.Entries)
+	}
+
+	// The largest number of first entries which fit limits
+	kept := sort.Search(total+1, func(n int) bool {
+		return n == total || !fits(msg.head(n+1))
+	})
+
+	return msg.head(kept)
+}
+
+// head returns the message with the first n entries. Other entries are counted as omitted
+func (m Message) head(n int) Message {
+	result := Message{Title: m.Title, Omitted: m.Omitted}
+
+	for _, group := range m.Groups {
+		if n < len(group.Entries) {
+			result.Omitted += len(group.Entries) - n
+			if n == 0 {
+				continue
+			}
+			group = Group{Title: group.Title, Entries: group.Entries[:n]}
+		}
+		n -= len(group.Entries)
+
+		result.Groups = append(result.Groups, group)
+	}
+
+	return result
+}
+
+// omittedNotice returns the note about entries dropped to fit limits
+func omittedNotice(msg Message) string {
+	return fmt.Sprintf("…and %d more changes", msg.Omitted)
+}
+
+// truncate cuts the text to the number of characters
+func truncate(text string, limit int) string {
+	if utf8.RuneCountInString(text) <= limit {
+		return text
+	}
+
+	runes := []rune(text)
+
+	return string(runes[:limit-1]) + "…"
+}
@@ -0,0 +1,173 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+func TestNewMessage(t *testing.T) {
+	convey.Convey("building messages from changes", t, func() {
+		changes := changelog.NewChanges()
+		changes.SetEntries(changelog.Added, []string{"add feature ABC-1"})
+		changes.SetEntries(changelog.Removed, []string{"BREAKING: remove API"})
+
+		convey.Convey("should group breaking changes and linkify references", func() {
+			msg := NewMessage("Release 1.0.0", changes, changelog.RenderOptions{
+				GroupBreaking: true,
+				LinkTemplates: map[changelog.RefKind]string{"": "https://tracker.local/{id}"},
+			})
+
+			convey.So(msg, convey.ShouldResemble, Message{
+				Title: "Release 1.0.0",
+				Groups: []Group{
+					{Title: changelog.BreakingChangesTitle, Entries: []string{"remove API"}},
+					{Title: "Added", Entries: []string{"add feature [ABC-1](https://tracker.local/ABC-1)"}},
+				},
+			})
+		})
+
+		convey.Convey("should fail on empty message", func() {
+			_, err := Payload(TargetSlack, NewMessage("Release", changelog.NewChanges(), changelog.RenderOptions{}))
+			convey.So(err, convey.ShouldEqual, ErrEmptyMessage)
+		})
+	})
+}
+
+func TestPayload(t *testing.T) {
+	convey.Convey("rendering payloads of platforms", t, func() {
+		msg := Message{
+			Title:  "Release 1.0.0",
+			Groups: []Group{{Title: "Fixed", Entries: []string{"fix **a < b** in [#12](https://github.com/org/repo/issues/12)"}}},
+		}
+
+		convey.Convey("should render Slack blocks", func() {
+			payload, err := Payload(TargetSlack, msg)
+
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(payload, convey.ShouldResemble, slackMessage{
+				Text: "Release 1.0.0",
+				Blocks: []slackBlock{
+					{Type: "header", Text: &slackText{Type: "plain_text", Text: "Release 1.0.0"}},
+					{Type: "section", Text: &slackText{Type: "mrkdwn", Text: "*Fixed*\n• fix *a &lt; b* in <https://github.com/org/repo/issues/12|#12>"}},
+				},
+			})
+		})
+
+		convey.Convey("should render Mattermost markdown", func() {
+			payload, err := Payload(TargetMattermost, msg)
+
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(payload, convey.ShouldResemble, mattermostMessage{
+				Text: "#### Release 1.0.0\n\n##### Fixed\n- fix **a < b** in [#12](https://github.com/org/repo/issues/12)",
+			})
+		})
+
+		convey.Convey("should render Teams adaptive card", func() {
+			payload, err := Payload(TargetTeams, msg)
+
+			convey.So(err, convey.ShouldBeNil)
+
+			card := payload.(teamsMessage).Attachments[0]
+			convey.So(card.ContentType, convey.ShouldEqual, "application/vnd.microsoft.card.adaptive")
+			convey.So(card.Content.Type, convey.ShouldEqual, "AdaptiveCard")
+			convey.So(card.Content.Body, convey.ShouldHaveLength, 3)
+			convey.So(card.Content.Body[1].Text, convey.ShouldEqual, "Fixed")
+			convey.So(card.Content.Body[2].Text, convey.ShouldEqual, "- "+msg.Groups[0].Entries[0])
+		})
+	})
+}
+
+func TestPayloadLimits(t *testing.T) {
+	convey.Convey("fitting limits of platforms", t, func() {
+		msg := Message{Title: strings.Repeat("T", 200)}
+		for i := 0; i < 60; i++ {
+			entries := make([]string, 0, 50)
+			for j := 0; j < 50; j++ {
+				entries = append(entries, fmt.Sprintf("entry %d.%d %s", i, j, strings.Repeat("x", 100)))
+			}
+			msg.Groups = append(msg.Groups, Group{Title: fmt.Sprintf("Kind %d", i), Entries: entries})
+		}
+
+		convey.Convey("should fit Slack blocks", func() {
+			payload, err := Payload(TargetSlack, msg)
+			convey.So(err, convey.ShouldBeNil)
+
+			blocks := payload.(slackMessage).Blocks
+			convey.So(len(blocks), convey.ShouldBeLessThanOrEqualTo, slackMaxBlocks)
+			convey.So(utf8.RuneCountInString(blocks[0].Text.Text), convey.ShouldEqual, slackMaxHeaderText)
+			for _, block := range blocks[1 : len(blocks)-1] {
+				convey.So(utf8.RuneCountInString(block.Text.Text), convey.ShouldBeLessThanOrEqualTo, slackMaxSectionText)
+			}
+			convey.So(blocks[len(blocks)-1].Type, convey.ShouldEqual, "context")
+			convey.So(blocks[len(blocks)-1].Elements[0].Text, convey.ShouldStartWith, "…and ")
+		})
+
+		convey.Convey("should fit Mattermost post", func() {
+			payload, err := Payload(TargetMattermost, msg)
+			convey.So(err, convey.ShouldBeNil)
+
+			text := payload.(mattermostMessage).Text
+			convey.So(utf8.RuneCountInString(text), convey.ShouldBeLessThanOrEqualTo, mattermostMaxText)
+			convey.So(text, convey.ShouldEndWith, " more changes_")
+		})
+
+		convey.Convey("should fit Teams payload", func() {
+			payload, err := Payload(TargetTeams, msg)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(toJSON(payload)), convey.ShouldBeLessThanOrEqualTo, teamsMaxPayload)
+		})
+
+		convey.Convey("should keep the source message", func() {
+			_, _ = Payload(TargetSlack, msg)
+			convey.So(msg.Groups[59].Entries, convey.ShouldHaveLength, 50)
+		})
+	})
+}
+
+func TestSend(t *testing.T) {
+	convey.Convey("sending notifications", t, func() {
+		var received string
+		status := http.StatusOK
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			received = r.Method + " " + r.Header.Get("Content-Type") + " " + string(body)
+
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte("invalid_payload"))
+		}))
+		defer server.Close()
+
+		convey.Convey("should post payload to the webhook", func() {
+			err := Send(context.Background(), nil, server.URL, mattermostMessage{Text: "hello"})
+
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(received, convey.ShouldEqual, `POST application/json {"text":"hello"}`)
+		})
+
+		convey.Convey("should fail on error response", func() {
+			status = http.StatusBadRequest
+			err := Send(context.Background(), server.Client(), server.URL, mattermostMessage{Text: "hello"})
+
+			convey.So(errors.Is(err, ErrSendFailed), convey.ShouldBeTrue)
+			convey.So(err.Error(), convey.ShouldContainSubstring, "invalid_payload")
+		})
+	})
+}
+
+func toJSON(v any) string {
+	output, _ := json.Marshal(v)
+	return string(output)
+}
@@ -0,0 +1,94 @@
+package notify
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	// Limits of Slack Block Kit
+	slackMaxBlocks      = 50
+	slackMaxHeaderText  = 150
+	slackMaxSectionText = 3000
+)
+
+var (
+	reMarkdownLink = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	reMarkdownBold = regexp.MustCompile(`\*\*(.+?)\*\*`)
+
+	slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+)
+
+type slackMessage struct {
+	// Text is the fallback for notifications
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+type slackBlock struct {
+	Type     string      `json:"type"`
+	Text     *slackText  `json:"text,omitempty"`
+	Elements []slackText `json:"elements,omitempty"`
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+func slackPayload(msg Message) slackMessage {
+	msg = fit(msg, func(m Message) bool {
+		return len(renderSlackBlocks(m)) <= slackMaxBlocks
+	})
+
+	return slackMessage{
+		Text:   msg.Title,
+		Blocks: renderSlackBlocks(msg),
+	}
+}
+
+// renderSlackBlocks renders the header and sections of groups. Long groups are split into several sections
+func renderSlackBlocks(msg Message) []slackBlock {
+	blocks := []slackBlock{{
+		Type: "header",
+		Text: &slackText{Type: "plain_text", Text: truncate(msg.Title, slackMaxHeaderText)},
+	}}
+
+	for _, group := range msg.Groups {
+		text := "*" + slackEscaper.Replace(group.Title) + "*"
+
+		for _, entry := range group.Entries {
+			line := truncate("• "+toSlackMarkdown(entry), slackMaxSectionText)
+			if len([]rune(text))+1+len([]rune(line)) > slackMaxSectionText {
+				blocks = append(blocks, newSlackSection(text))
+				text = line
+				continue
+			}
+
+			text += "\n" + line
+		}
+
+		blocks = append(blocks, newSlackSection(text))
+	}
+
+	if msg.Omitted > 0 {
+		blocks = append(blocks, slackBlock{
+			Type:     "context",
+			Elements: []slackText{{Type: "mrkdwn", Text: omittedNotice(msg)}},
+		})
+	}
+
+	return blocks
+}
+
+func newSlackSection(text string) slackBlock {
+	return slackBlock{Type: "section", Text: &slackText{Type: "mrkdwn", Text: text}}
+}
+
+// toSlackMarkdown converts markdown links and bold text into Slack mrkdwn format
+func toSlackMarkdown(text string) string {
+	text = slackEscaper.Replace(text)
+	text = reMarkdownLink.ReplaceAllString(text, "<$2|$1>")
+
+	return reMarkdownBold.ReplaceAllString(text, "*$1*")
+}
@@ -0,0 +1,78 @@
+package notify
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// teamsMaxPayload is the maximal size of the message of Microsoft Teams webhooks in bytes
+const teamsMaxPayload = 28 * 1024
+
+type teamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []teamsAttachment `json:"attachments"`
+}
+
+type teamsAttachment struct {
+	ContentType string    `json:"contentType"`
+	Content     teamsCard `json:"content"`
+}
+
+type teamsCard struct {
+	Schema  string           `json:"$schema"`
+	Type    string           `json:"type"`
+	Version string           `json:"version"`
+	Body    []teamsTextBlock `json:"body"`
+}
+
+type teamsTextBlock struct {
+	Type     string `json:"type"`
+	Text     string `json:"text"`
+	Size     string `json:"size,omitempty"`
+	Weight   string `json:"weight,omitempty"`
+	IsSubtle bool   `json:"isSubtle,omitempty"`
+	Wrap     bool   `json:"wrap"`
+}
+
+func teamsPayload(msg Message) teamsMessage {
+	msg = fit(msg, func(m Message) bool {
+		payload, err := json.Marshal(renderTeamsCard(m))
+		return err == nil && len(payload) <= teamsMaxPayload
+	})
+
+	return renderTeamsCard(msg)
+}
+
+// renderTeamsCard renders the message as the adaptive card
+func renderTeamsCard(msg Message) teamsMessage {
+	body := []teamsTextBlock{{Type: "TextBlock", Text: msg.Title, Size: "Large", Weight: "Bolder", Wrap: true}}
+
+	for _, group := range msg.Groups {
+		entries := make([]string, 0, len(group.Entries))
+		for _, entry := range group.Entries {
+			entries = append(entries, "- "+entry)
+		}
+
+		body = append(body,
+			teamsTextBlock{Type: "TextBlock", Text: group.Title, Weight: "Bolder", Wrap: true},
+			teamsTextBlock{Type: "TextBlock", Text: strings.Join(entries, "\n"), Wrap: true},
+		)
+	}
+
+	if msg.Omitted > 0 {
+		body = append(body, teamsTextBlock{Type: "TextBlock", Text: omittedNotice(msg), IsSubtle: true, Wrap: true})
+	}
+
+	return teamsMessage{
+		Type: "message",
+		Attachments: []teamsAttachment{{
+			ContentType: "application/vnd.microsoft.card.adaptive",
+			Content: teamsCard{
+				Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+				Type:    "AdaptiveCard",
+				Version: "1.4",
+				Body:    body,
+			},
+		}},
+	}
+}
@@ -46,3 +46,76 @@ func TestParseMarkdownFile_ToMarkdown(t *testing.T) {
 		})
 	})
 }
+
+func TestParseMarkdownFile_InlineMarkup(t *testing.T) {
+	const md = "# Changelog\n\nSee [docs](https://example.com).\n\n## Unreleased\n### Added\n- Add `-flag` parameter\n- Multiline\n  entry"
+
+	convey.Convey("parsing changelog with inline markup", t, func() {
+		cl := ParseMarkdownFile([]byte(md))
+
+		convey.Convey("should keep markup of the header and entries", func() {
+			convey.So(cl.Header, convey.ShouldEqual, "# Changelog")
+			convey.So(cl.Description, convey.ShouldEqual, "See [docs](https://example.com).")
+
+			unreleased, _ := cl.GetChanges(changelog.Unreleased)
+			convey.So(unreleased.GetEntries(changelog.Added), convey.ShouldResemble, []string{"Add `-flag` parameter", "Multiline\n  entry"})
+		})
+	})
+}
+
+func TestParseMarkdownFile_VersionDescription(t *testing.T) {
+	const md = "## [1.1.0] - 2024-02-01 [YANKED]\n\nBroken build.\n\n### Fixed\n- fix\n## [1.0.0] - 2024-01-01\n\nFirst release.\n"
+
+	convey.Convey("parsing changelog with descriptions of versions", t, func() {
+		cl := ParseMarkdownFile([]byte(md))
+
+		convey.Convey("should keep descriptions and yanked markers", func() {
+			convey.So(cl.Versions["1.1.0"].Description, convey.ShouldEqual, "Broken build.")
+			convey.So(cl.Versions["1.1.0"].Version.IsYanked(), convey.ShouldBeTrue)
+			convey.So(cl.Versions["1.0.0"].Description, convey.ShouldEqual, "First release.")
+			convey.So(cl.Versions["1.0.0"].Changes.GetKinds(), convey.ShouldBeEmpty)
+			convey.So(cl.ToMarkdown(), convey.ShouldEqual, "## [1.1.0] - 2024-02-01 [YANKED]\n\nBroken build.\n\n### Fixed\n- fix\n\n## [1.0.0] - 2024-01-01\n\nFirst release.")
+		})
+	})
+}
+
+func TestParseMarkdownFile_Links(t *testing.T) {
+	const md = "## [Unreleased]\n### Added\n- feature\n## [1.0.0] - 2024-01-01\n### Fixed\n- fix\n\n" +
+		"[unreleased]: https://github.com/org/repo/compare/v1.0.0...HEAD\n[1.0.0]: https://github.com/org/repo/releases/tag/v1.0.0\n"
+
+	convey.Convey("parsing changelog with link reference definitions", t, func() {
+		cl := ParseMarkdownFile([]byte(md))
+
+		convey.Convey("should associate links with versions", func() {
+			convey.So(cl.Links, convey.ShouldResemble, map[string]string{
+				"Unreleased": "https://github.com/org/repo/compare/v1.0.0...HEAD",
+				"1.0.0":      "https://github.com/org/repo/releases/tag/v1.0.0",
+			})
+
+			released, _ := cl.GetChanges(changelog.RequireVersionFromString("1.0.0", nil))
+			convey.So(released.GetEntries(changelog.Fixed), convey.ShouldResemble, []string{"fix"})
+		})
+
+		convey.Convey("should render links in the footer", func() {
+			convey.So(cl.ToMarkdown(), convey.ShouldEndWith, "- fix\n\n"+
+				"[Unreleased]: https://github.com/org/repo/compare/v1.0.0...HEAD\n[1.0.0]: https://github.com/org/repo/releases/tag/v1.0.0")
+		})
+	})
+}
+
+func TestParseMarkdownFile_BestEffort(t *testing.T) {
+	const md = "# Changelog\n\n## [Unreleased]\n### Added\n- feature\n\n## Not a version\n- skipped\n\n## [1.0.0] - 2024-01-01\n### Fixed\n- fix\n\n## [1.0.0] - 2024-01-01\n### Fixed\n- another fix"
+
+	convey.Convey("parsing changelog with invalid content", t, func() {
+		cl := ParseMarkdownFile([]byte(md))
+
+		convey.Convey("should keep the parsed part", func() {
+			convey.So(cl.Header, convey.ShouldEqual, "# Changelog")
+			convey.So(cl.Versions, convey.ShouldHaveLength, 2)
+
+			changes, ok := cl.GetChanges(changelog.RequireVersionFromString("1.0.0", nil))
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(changes.GetEntries(changelog.Fixed), convey.ShouldResemble, []string{"fix", "another fix"})
+		})
+	})
+}
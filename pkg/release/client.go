@@ -0,0 +1,136 @@
+package release
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	DefaultGitHubURL = "https://api.github.com"
+	DefaultGitLabURL = "https://gitlab.com/api/v4"
+
+	// maxErrorBody is the maximal length of the response body included into errors
+	maxErrorBody = 512
+)
+
+var (
+	ErrRepositoryRequired = errors.New("repository is required")
+	ErrTokenRequired      = errors.New("token is required")
+	ErrPublishFailed      = errors.New("unable to publish release")
+)
+
+// Client publishes releases by API of the provider
+type Client struct {
+	Provider Provider
+	// BaseURL is the URL of the API (DefaultGitHubURL or DefaultGitLabURL by default)
+	BaseURL string
+	// Repository is "owner/repo" for GitHub and ID or path of the project for GitLab
+	Repository string
+	Token      string
+	HTTPClient *http.Client
+}
+
+// Publish creates the release and returns its URL
+func (c *Client) Publish(ctx context.Context, payload any) (string, error) {
+	if c.Repository == "" {
+		return "", ErrRepositoryRequired
+	}
+
+	if c.Token == "" {
+		return "", ErrTokenRequired
+	}
+
+	endpoint, err := c.getEndpoint()
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	switch c.Provider {
+	case ProviderGitHub:
+		req.Header.Set("Accept", "application/vnd.github+json")
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	case ProviderGitLab:
+		req.Header.Set("PRIVATE-TOKEN", c.Token)
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		if len(respBody) > maxErrorBody {
+			respBody = respBody[:maxErrorBody]
+		}
+
+		return "", fmt.Errorf("%w: %s: %s", ErrPublishFailed, resp.Status, strings.TrimSpace(string(respBody)))
+	}
+
+	var created struct {
+		HTMLURL string `json:"html_url"`
+		Links   struct {
+			Self string `json:"self"`
+		} `json:"_links"`
+	}
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrPublishFailed, err)
+	}
+
+	if created.HTMLURL != "" {
+		return created.HTMLURL, nil
+	}
+
+	return created.Links.Self, nil
+}
+
+func (c *Client) getEndpoint() (string, error) {
+	baseURL := strings.TrimRight(c.BaseURL, "/")
+
+	switch c.Provider {
+	case ProviderGitHub:
+		if baseURL == "" {
+			baseURL = DefaultGitHubURL
+		}
+
+		return fmt.Sprintf("%s/repos/%s/releases", baseURL, c.Repository), nil
+	case ProviderGitLab:
+		if baseURL == "" {
+			baseURL = DefaultGitLabURL
+		}
+
+		return fmt.Sprintf("%s/projects/%s/releases", baseURL, url.PathEscape(c.Repository)), nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownProvider, c.Provider)
+	}
+}
@@ -0,0 +1,92 @@
+// Package release builds payloads of release APIs of GitHub and GitLab from versions of the changelog and publishes them
+package release
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+const (
+	ProviderGitHub Provider = "github"
+	ProviderGitLab Provider = "gitlab"
+)
+
+var ErrUnknownProvider = errors.New("unknown provider")
+
+// Provider is the hosting providing release API
+type Provider string
+
+func NewProvider(provider string) (Provider, error) {
+	switch Provider(provider) {
+	case ProviderGitHub, ProviderGitLab:
+		return Provider(provider), nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownProvider, provider)
+	}
+}
+
+// Options are parameters of the release
+type Options struct {
+	// TagPrefix is the prefix of git tags of versions (e.g. "v")
+	TagPrefix string
+	// Draft creates unpublished release (GitHub only)
+	Draft bool
+	// RenderOptions are options of rendering the body of the release
+	RenderOptions changelog.RenderOptions
+}
+
+// GitHubPayload is the body of the request creating the release by GitHub API
+type GitHubPayload struct {
+	TagName    string `json:"tag_name"`
+	Name       string `json:"name"`
+	Body       string `json:"body"`
+	Draft      bool   `json:"draft"`
+	Prerelease bool   `json:"prerelease"`
+}
+
+// GitLabPayload is the body of the request creating the release by GitLab API. GitLab has neither drafts
+// nor pre-release flag
+type GitLabPayload struct {
+	TagName     string `json:"tag_name"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// NewPayload returns the payload of the provider for the released version
+func NewPayload(provider Provider, section changelog.VersionChanges, opts Options) (any, error) {
+	if !section.Version.IsCommon() {
+		return nil, fmt.Errorf("%w: %s", changelog.ErrVersionNotReleased, section.Version.GetVersion())
+	}
+
+	tag := opts.TagPrefix + string(section.Version.GetVersion())
+	body := GetBody(section, opts.RenderOptions)
+
+	switch provider {
+	case ProviderGitHub:
+		return GitHubPayload{
+			TagName:    tag,
+			Name:       tag,
+			Body:       body,
+			Draft:      opts.Draft,
+			Prerelease: section.Version.IsPrerelease(),
+		}, nil
+	case ProviderGitLab:
+		return GitLabPayload{
+			TagName:     tag,
+			Name:        tag,
+			Description: body,
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, provider)
+	}
+}
+
+// GetBody returns release notes of the version: the description and changes without the heading of the version
+func GetBody(section changelog.VersionChanges, opts changelog.RenderOptions) string {
+	_, body, _ := strings.Cut(section.Render(opts), "\n")
+
+	return strings.TrimSpace(body)
+}
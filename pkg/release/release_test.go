@@ -0,0 +1,124 @@
+package release
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+func TestNewPayload(t *testing.T) {
+	convey.Convey("building payloads of releases", t, func() {
+		date := time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC)
+
+		changes := changelog.NewChanges()
+		changes.SetEntries(changelog.Added, []string{"add feature"})
+		changes.SetEntries(changelog.Fixed, []string{"fix bug"})
+
+		section := changelog.NewVersionChanges(changelog.RequireVersionFromString("1.2.0-rc.1", &date), changes)
+		section.Description = "Release candidate"
+
+		body := "Release candidate\n\n### Fixed\n- fix bug\n\n### Added\n- add feature"
+
+		convey.Convey("should build GitHub payload with pre-release flag", func() {
+			payload, err := NewPayload(ProviderGitHub, section, Options{TagPrefix: "v", Draft: true})
+
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(payload, convey.ShouldResemble, GitHubPayload{
+				TagName:    "v1.2.0-rc.1",
+				Name:       "v1.2.0-rc.1",
+				Body:       body,
+				Draft:      true,
+				Prerelease: true,
+			})
+		})
+
+		convey.Convey("should build GitLab payload", func() {
+			payload, err := NewPayload(ProviderGitLab, section, Options{})
+
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(payload, convey.ShouldResemble, GitLabPayload{
+				TagName:     "1.2.0-rc.1",
+				Name:        "1.2.0-rc.1",
+				Description: body,
+			})
+		})
+
+		convey.Convey("should fail for unreleased version", func() {
+			_, err := NewPayload(ProviderGitHub, changelog.NewVersionChanges(changelog.Unreleased, changes), Options{})
+			convey.So(errors.Is(err, changelog.ErrVersionNotReleased), convey.ShouldBeTrue)
+		})
+	})
+}
+
+func TestClient_Publish(t *testing.T) {
+	convey.Convey("publishing releases", t, func() {
+		var request *http.Request
+		var received map[string]any
+
+		status := http.StatusCreated
+		response := ""
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			request = r
+			_ = json.NewDecoder(r.Body).Decode(&received)
+
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(response))
+		}))
+		defer server.Close()
+
+		convey.Convey("should post payload to GitHub API", func() {
+			response = `{"html_url": "https://github.com/org/repo/releases/tag/v1.0.0"}`
+			client := &Client{Provider: ProviderGitHub, BaseURL: server.URL + "/", Repository: "org/repo", Token: "secret"}
+
+			url, err := client.Publish(context.Background(), GitHubPayload{TagName: "v1.0.0", Name: "v1.0.0", Body: "- fix"})
+
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(url, convey.ShouldEqual, "https://github.com/org/repo/releases/tag/v1.0.0")
+			convey.So(request.Method, convey.ShouldEqual, http.MethodPost)
+			convey.So(request.URL.Path, convey.ShouldEqual, "/repos/org/repo/releases")
+			convey.So(request.Header.Get("Authorization"), convey.ShouldEqual, "Bearer secret")
+			convey.So(received["tag_name"], convey.ShouldEqual, "v1.0.0")
+			convey.So(received["prerelease"], convey.ShouldEqual, false)
+		})
+
+		convey.Convey("should post payload to GitLab API", func() {
+			response = `{"_links": {"self": "https://gitlab.com/org/repo/-/releases/v1.0.0"}}`
+			client := &Client{Provider: ProviderGitLab, BaseURL: server.URL, Repository: "org/repo", Token: "secret"}
+
+			url, err := client.Publish(context.Background(), GitLabPayload{TagName: "v1.0.0", Name: "v1.0.0", Description: "- fix"})
+
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(url, convey.ShouldEqual, "https://gitlab.com/org/repo/-/releases/v1.0.0")
+			convey.So(request.URL.EscapedPath(), convey.ShouldEqual, "/projects/org%2Frepo/releases")
+			convey.So(request.Header.Get("PRIVATE-TOKEN"), convey.ShouldEqual, "secret")
+			convey.So(received["description"], convey.ShouldEqual, "- fix")
+		})
+
+		convey.Convey("should fail on error response", func() {
+			status = http.StatusUnprocessableEntity
+			response = `{"message": "Validation Failed"}`
+			client := &Client{Provider: ProviderGitHub, BaseURL: server.URL, Repository: "org/repo", Token: "secret"}
+
+			_, err := client.Publish(context.Background(), GitHubPayload{TagName: "v1.0.0"})
+
+			convey.So(errors.Is(err, ErrPublishFailed), convey.ShouldBeTrue)
+			convey.So(err.Error(), convey.ShouldContainSubstring, "Validation Failed")
+		})
+
+		convey.Convey("should require token", func() {
+			client := &Client{Provider: ProviderGitHub, BaseURL: server.URL, Repository: "org/repo"}
+
+			_, err := client.Publish(context.Background(), GitHubPayload{})
+			convey.So(err, convey.ShouldEqual, ErrTokenRequired)
+		})
+	})
+}
@@ -0,0 +1,118 @@
+// Package rpm renders versions of the changelog as %changelog section of RPM spec files
+package rpm
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+// dateLayout is the date format of %changelog entries
+const dateLayout = "Mon Jan 02 2006"
+
+var (
+	ErrPackagerRequired = errors.New("packager is required")
+	ErrDateRequired     = errors.New("release date is required")
+)
+
+var (
+	reChangelogSection = regexp.MustCompile(`^%changelog\s*$`)
+	// reSection matches headers of sections which can follow %changelog
+	reSection = regexp.MustCompile(`^%(package|description|prep|build|install|check|clean|files|pre|post|preun|postun|pretrans|posttrans|triggerin|triggerun|triggerpostun|verifyscript)\b`)
+)
+
+// Options are parameters of %changelog entries
+type Options struct {
+	// Packager is the name and the email of the packager ("Name <email>")
+	Packager string
+	// Release is the release of the package ("1" by default)
+	Release string
+}
+
+// Render renders released versions as %changelog entries in the order of sections (without the section header).
+// Unreleased changes are skipped, released versions without date make rendering fail with ErrDateRequired
+func Render(sections []changelog.VersionChanges, opts Options) (string, error) {
+	if opts.Packager == "" {
+		return "", ErrPackagerRequired
+	}
+
+	if opts.Release == "" {
+		opts.Release = "1"
+	}
+
+	entries := make([]string, 0, len(sections))
+	for _, section := range sections {
+		if !section.Version.IsCommon() {
+			continue
+		}
+
+		if section.Version.GetDate().IsZero() {
+			return "", fmt.Errorf("%w: %s", ErrDateRequired, section.Version.GetVersion())
+		}
+
+		entries = append(entries, renderEntry(section, opts))
+	}
+
+	return strings.Join(entries, "\n"), nil
+}
+
+func renderEntry(section changelog.VersionChanges, opts Options) string {
+	output := fmt.Sprintf("* %s %s - %s-%s\n", section.Version.GetDate().Format(dateLayout), opts.Packager, GetVersion(section.Version), opts.Release)
+
+	for _, kind := range section.Changes.GetKinds() {
+		for _, entry := range section.Changes.GetEntries(kind) {
+			// Macros are expanded in %changelog, so percent signs are escaped
+			output += "- " + strings.ReplaceAll(entry, "%", "%%") + "\n"
+		}
+	}
+
+	return output
+}
+
+// GetVersion returns the RPM version: pre-releases are sorted before releases with "~" (1.0.0-rc.1 → 1.0.0~rc.1)
+func GetVersion(ver changelog.Version) string {
+	return strings.ReplaceAll(string(ver.GetVersion()), "-", "~")
+}
+
+// ReplaceChangelog replaces the content of %changelog section of the spec file. The section is added
+// to the end of the spec if it's missing
+func ReplaceChangelog(spec []byte, entries string) ([]byte, error) {
+	output := bytes.NewBuffer(make([]byte, 0, len(spec)+len(entries)))
+	found, inSection := false, false
+
+	scanner := bufio.NewScanner(bytes.NewReader(spec))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if inSection && reSection.MatchString(line) {
+			inSection = false
+			output.WriteString("\n")
+		}
+
+		if inSection {
+			continue
+		}
+
+		output.WriteString(line + "\n")
+
+		if !found && reChangelogSection.MatchString(line) {
+			found, inSection = true, true
+			output.WriteString(entries)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if !found {
+		output.WriteString("\n%changelog\n" + entries)
+	}
+
+	return output.Bytes(), nil
+}
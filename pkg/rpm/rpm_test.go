@@ -0,0 +1,65 @@
+package rpm
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+func TestRender(t *testing.T) {
+	convey.Convey("rendering rpm changelog", t, func() {
+		date := time.Date(2024, 1, 29, 0, 0, 0, 0, time.UTC)
+
+		changes := changelog.NewChanges()
+		changes.SetEntries(changelog.Fixed, []string{"fix 100% CPU usage"})
+		changes.SetEntries(changelog.Added, []string{"add feature"})
+
+		sections := []changelog.VersionChanges{
+			changelog.NewVersionChanges(changelog.Unreleased, changes),
+			changelog.NewVersionChanges(changelog.RequireVersionFromString("1.2.0-rc.1", &date), changes),
+		}
+
+		convey.Convey("should render released versions", func() {
+			output, err := Render(sections, Options{Packager: "Jane Doe <jane@example.com>"})
+
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(output, convey.ShouldEqual, "* Mon Jan 29 2024 Jane Doe <jane@example.com> - 1.2.0~rc.1-1\n- fix 100%% CPU usage\n- add feature\n")
+		})
+
+		convey.Convey("should require packager", func() {
+			_, err := Render(sections, Options{})
+			convey.So(err, convey.ShouldEqual, ErrPackagerRequired)
+		})
+
+		convey.Convey("should require dates of released versions", func() {
+			undated := changelog.NewVersionChanges(changelog.RequireVersionFromString("1.3.0", nil), changes)
+
+			_, err := Render(append(sections, undated), Options{Packager: "Jane Doe <jane@example.com>"})
+			convey.So(errors.Is(err, ErrDateRequired), convey.ShouldBeTrue)
+		})
+	})
+}
+
+func TestReplaceChangelog(t *testing.T) {
+	const entries = "* Mon Jan 29 2024 Jane Doe <jane@example.com> - 1.0.0-1\n- first\n"
+
+	convey.Convey("replacing %changelog section", t, func() {
+		convey.Convey("should replace the content of the section", func() {
+			spec := "Name: app\n\n%description\nApp\n\n%changelog\n* Mon Jan 01 2024 Old <old@example.com> - 0.1.0-1\n- old\n\n%files\n/usr/bin/app\n"
+
+			output, err := ReplaceChangelog([]byte(spec), entries)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(string(output), convey.ShouldEqual, "Name: app\n\n%description\nApp\n\n%changelog\n"+entries+"\n%files\n/usr/bin/app\n")
+		})
+
+		convey.Convey("should add the missing section", func() {
+			output, err := ReplaceChangelog([]byte("Name: app\n"), entries)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(string(output), convey.ShouldEqual, "Name: app\n\n%changelog\n"+entries)
+		})
+	})
+}
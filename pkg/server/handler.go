@@ -0,0 +1,274 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+const changelogsPath = "/changelogs"
+
+var (
+	errNotFound         = errors.New("not found")
+	errMethodNotAllowed = errors.New("method not allowed")
+)
+
+type changelogResult struct {
+	Name          string                  `json:"name"`
+	LatestVersion changelog.VersionString `json:"latest_version"`
+}
+
+type versionResult struct {
+	Version changelog.VersionString `json:"version"`
+	Date    *time.Time              `json:"date,omitempty"`
+	Yanked  bool                    `json:"yanked"`
+}
+
+type sectionResult struct {
+	versionResult
+	Description string       `json:"description,omitempty"`
+	Changes     []kindResult `json:"changes"`
+}
+
+type kindResult struct {
+	Kind    changelog.ChangesKind `json:"kind"`
+	Entries []string              `json:"entries"`
+}
+
+type diffResult struct {
+	From     changelog.VersionString `json:"from"`
+	To       changelog.VersionString `json:"to"`
+	Changes  []kindResult            `json:"changes"`
+	Markdown string                  `json:"markdown"`
+}
+
+type directionResult struct {
+	From      changelog.VersionString `json:"from"`
+	To        changelog.VersionString `json:"to"`
+	Direction changelog.Direction     `json:"direction"`
+}
+
+type errorResult struct {
+	Error string `json:"error"`
+}
+
+// ServeHTTP routes requests:
+//
+//	GET /changelogs                              - names and latest versions of changelogs
+//	GET /changelogs/{name}/versions[?range=]     - versions (newest first), optionally filtered by the range expression
+//	GET /changelogs/{name}/versions/{version}    - the section of the version (keyword "latest" is supported)
+//	GET /changelogs/{name}/latest                - the latest released version
+//	GET /changelogs/{name}/diff[?from=&to=]      - changes between versions (from latest to Unreleased by default)
+//	GET /changelogs/{name}/direction?from=&to=   - the direction of the deployment between versions
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		writeError(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+		return
+	}
+
+	path := strings.Trim(r.URL.Path, "/")
+	if path != strings.Trim(changelogsPath, "/") && !strings.HasPrefix(path, strings.Trim(changelogsPath, "/")+"/") {
+		writeError(w, http.StatusNotFound, errNotFound)
+		return
+	}
+
+	parts := strings.Split(path, "/")[1:]
+	if len(parts) == 0 {
+		s.listChangelogs(w)
+		return
+	}
+
+	cl, ok := s.Get(parts[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("changelog %s is %w", parts[0], errNotFound))
+		return
+	}
+
+	switch {
+	case len(parts) == 2 && parts[1] == "versions":
+		listVersions(w, r, cl)
+	case len(parts) == 3 && parts[1] == "versions":
+		getVersion(w, cl, parts[2])
+	case len(parts) == 2 && parts[1] == "latest":
+		writeJSON(w, http.StatusOK, newVersionResult(cl.GetLatestVersion()))
+	case len(parts) == 2 && parts[1] == "diff":
+		s.getDiff(w, r, cl)
+	case len(parts) == 2 && parts[1] == "direction":
+		getDirection(w, r, cl)
+	default:
+		writeError(w, http.StatusNotFound, errNotFound)
+	}
+}
+
+func (s *Server) listChangelogs(w http.ResponseWriter) {
+	result := make([]changelogResult, 0, len(s.names))
+	for _, name := range s.names {
+		cl, _ := s.Get(name)
+		result = append(result, changelogResult{Name: name, LatestVersion: cl.GetLatestVersion().GetVersion()})
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func listVersions(w http.ResponseWriter, r *http.Request, cl *changelog.Changelog) {
+	filter, err := changelog.NewVersionFilterFromRange(r.URL.Query().Get("range"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	result := make([]versionResult, 0, len(cl.Versions))
+	for _, ver := range cl.GetFilteredVersions(filter) {
+		result = append(result, newVersionResult(ver))
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func getVersion(w http.ResponseWriter, cl *changelog.Changelog, version string) {
+	ver, err := parseVersion(cl, version)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	section, ok := cl.Versions[ver.GetVersion()]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("%w: %s", changelog.ErrVersionNotFound, ver.GetVersion()))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, sectionResult{
+		versionResult: newVersionResult(section.Version),
+		Description:   section.Description,
+		Changes:       newKindResults(section.Changes),
+	})
+}
+
+func (s *Server) getDiff(w http.ResponseWriter, r *http.Request, cl *changelog.Changelog) {
+	from, to, err := parseBounds(r, cl, string(changelog.LatestValue), string(changelog.UnreleasedValue))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// The diff between the same versions is changes of exactly this version
+	filter := changelog.VersionFilter{From: from, To: to, ExcludeFrom: true}
+	if from.Equal(to) {
+		filter = changelog.VersionFilter{From: to, To: to}
+	} else if from.GreaterThan(to) {
+		filter = changelog.VersionFilter{From: to, To: from, ExcludeFrom: true}
+	}
+
+	changes := cl.GetDiffByFilter(filter)
+
+	writeJSON(w, http.StatusOK, diffResult{
+		From:     from.GetVersion(),
+		To:       to.GetVersion(),
+		Changes:  newKindResults(changes),
+		Markdown: changes.Render(s.opts.RenderOptions),
+	})
+}
+
+func getDirection(w http.ResponseWriter, r *http.Request, cl *changelog.Changelog) {
+	from, to, err := parseBounds(r, cl, "", "")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if from.IsUnrealized() || to.IsUnrealized() {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("%w: direction requires released versions", changelog.ErrVersionNotReleased))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, directionResult{
+		From:      from.GetVersion(),
+		To:        to.GetVersion(),
+		Direction: changelog.GetDirection(from, to),
+	})
+}
+
+// parseBounds parses 'from' and 'to' query params. Keyword "latest" is replaced by the latest version
+func parseBounds(r *http.Request, cl *changelog.Changelog, defaultFrom, defaultTo string) (from, to changelog.Version, err error) {
+	query := r.URL.Query()
+
+	from, err = parseParam(cl, "from", valueOrDefault(query.Get("from"), defaultFrom))
+	if err != nil {
+		return from, to, err
+	}
+
+	to, err = parseParam(cl, "to", valueOrDefault(query.Get("to"), defaultTo))
+
+	return from, to, err
+}
+
+func parseParam(cl *changelog.Changelog, name, value string) (changelog.Version, error) {
+	if value == "" {
+		return changelog.Empty, fmt.Errorf("parameter '%s' is required", name)
+	}
+
+	ver, err := parseVersion(cl, value)
+	if err != nil {
+		return ver, fmt.Errorf("wrong '%s' version: %w", name, err)
+	}
+
+	return ver, nil
+}
+
+// parseVersion parses the version replacing keyword "latest" by the latest version of the changelog
+func parseVersion(cl *changelog.Changelog, version string) (changelog.Version, error) {
+	ver, err := changelog.NewVersion(changelog.VersionString(version), nil)
+	if err != nil {
+		return ver, err
+	}
+
+	if ver.IsLatest() {
+		ver = cl.GetLatestVersion()
+	}
+
+	return ver, nil
+}
+
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
+
+func newVersionResult(ver changelog.Version) versionResult {
+	result := versionResult{Version: ver.GetVersion(), Yanked: ver.IsYanked()}
+	if date := ver.GetDate(); !date.IsZero() {
+		result.Date = &date
+	}
+
+	return result
+}
+
+func newKindResults(changes changelog.Changes) []kindResult {
+	result := make([]kindResult, 0)
+	for _, kind := range changes.GetKinds() {
+		result = append(result, kindResult{Kind: kind, Entries: changes.GetEntries(kind)})
+	}
+
+	return result
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResult{Error: err.Error()})
+}
@@ -0,0 +1,157 @@
+// Package server serves queries of changelogs (versions, diffs, directions) as JSON over HTTP.
+// Changelogs are reloaded when their files are changed
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+// DefaultReloadInterval is the interval of checking changes of files of changelogs
+const DefaultReloadInterval = 2 * time.Second
+
+var (
+	ErrNoSources     = errors.New("no changelogs to serve")
+	ErrInvalidSource = errors.New("invalid changelog source")
+)
+
+// Source is the file of the changelog served by the name
+type Source struct {
+	Name string
+	Path string
+}
+
+// Options are parameters of the server
+type Options struct {
+	// ReloadInterval is the interval of checking changes of files (DefaultReloadInterval by default)
+	ReloadInterval time.Duration
+	// ParseOptions are options of reading changelogs
+	ParseOptions []changelog.Option
+	// RenderOptions are options of rendering markdown of diffs
+	RenderOptions changelog.RenderOptions
+	// ErrorLog is the logger of reloading errors (the standard logger by default)
+	ErrorLog *log.Logger
+}
+
+// Server serves changelogs. The set of changelogs is fixed on creating, each changelog is replaced atomically
+// on reloading, so requests always read the complete parsed changelog
+type Server struct {
+	opts       Options
+	names      []string
+	changelogs map[string]*entry
+}
+
+type entry struct {
+	source    Source
+	changelog atomic.Pointer[changelog.Changelog]
+
+	// modTime and size of the loaded file are accessed by the reloading goroutine only
+	modTime time.Time
+	size    int64
+}
+
+// New loads changelogs of sources and returns the server
+func New(sources []Source, opts Options) (*Server, error) {
+	if len(sources) == 0 {
+		return nil, ErrNoSources
+	}
+
+	if opts.ReloadInterval <= 0 {
+		opts.ReloadInterval = DefaultReloadInterval
+	}
+
+	if opts.ErrorLog == nil {
+		opts.ErrorLog = log.Default()
+	}
+
+	s := &Server{opts: opts, changelogs: make(map[string]*entry, len(sources))}
+
+	for _, source := range sources {
+		if source.Name == "" || source.Path == "" {
+			return nil, fmt.Errorf("%w: name and path are required", ErrInvalidSource)
+		}
+
+		if _, exists := s.changelogs[source.Name]; exists {
+			return nil, fmt.Errorf("%w: duplicate name %s", ErrInvalidSource, source.Name)
+		}
+
+		e := &entry{source: source}
+		if err := s.load(e); err != nil {
+			return nil, fmt.Errorf("%s: %w", source.Name, err)
+		}
+
+		s.names = append(s.names, source.Name)
+		s.changelogs[source.Name] = e
+	}
+
+	return s, nil
+}
+
+// Run reloads changed changelogs until the context is done. Errors of reloading are logged and the previous
+// version of the changelog is served
+func (s *Server) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.opts.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.Reload()
+		}
+	}
+}
+
+// Reload reloads changelogs which files are changed since the previous loading
+func (s *Server) Reload() {
+	for _, name := range s.names {
+		e := s.changelogs[name]
+
+		if err := s.load(e); err != nil {
+			s.opts.ErrorLog.Printf("Unable to reload changelog %s: %v", name, err)
+		}
+	}
+}
+
+// Get returns the current version of the changelog by the name
+func (s *Server) Get(name string) (*changelog.Changelog, bool) {
+	e, ok := s.changelogs[name]
+	if !ok {
+		return nil, false
+	}
+
+	return e.changelog.Load(), true
+}
+
+// load reads the file of the changelog if it's changed
+func (s *Server) load(e *entry) error {
+	info, err := os.Stat(e.source.Path)
+	if err != nil {
+		return err
+	}
+
+	if e.changelog.Load() != nil && info.ModTime().Equal(e.modTime) && info.Size() == e.size {
+		return nil
+	}
+
+	// The file is marked as loaded even on errors of parsing to avoid reporting them on each check
+	e.modTime = info.ModTime()
+	e.size = info.Size()
+
+	cl, err := changelog.Load(e.source.Path, s.opts.ParseOptions...)
+	if err != nil {
+		return err
+	}
+
+	e.changelog.Store(cl)
+
+	return nil
+}
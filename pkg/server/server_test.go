@@ -0,0 +1,191 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+const testChangelog = `# Changelog
+
+## [Unreleased]
+### Added
+- new feature
+
+## [1.1.0] - 2024-02-01
+### Fixed
+- fix bug
+
+## [1.0.0] - 2024-01-01
+### Added
+- first release
+`
+
+func TestServer(t *testing.T) {
+	convey.Convey("serving changelogs", t, func() {
+		path := filepath.Join(t.TempDir(), "CHANGELOG.md")
+		convey.So(os.WriteFile(path, []byte(testChangelog), 0o644), convey.ShouldBeNil)
+
+		s, err := New([]Source{{Name: "app", Path: path}}, Options{ErrorLog: log.New(io.Discard, "", 0)})
+		convey.So(err, convey.ShouldBeNil)
+
+		server := httptest.NewServer(s)
+		defer server.Close()
+
+		convey.Convey("should list changelogs", func() {
+			status, body := get(server.URL + "/changelogs")
+			convey.So(status, convey.ShouldEqual, http.StatusOK)
+			convey.So(body, convey.ShouldEqual, `[{"name":"app","latest_version":"1.1.0"}]`)
+		})
+
+		convey.Convey("should list versions", func() {
+			status, body := get(server.URL + "/changelogs/app/versions")
+			convey.So(status, convey.ShouldEqual, http.StatusOK)
+			convey.So(body, convey.ShouldEqual, `[{"version":"Unreleased","yanked":false},`+
+				`{"version":"1.1.0","date":"2024-02-01T00:00:00Z","yanked":false},`+
+				`{"version":"1.0.0","date":"2024-01-01T00:00:00Z","yanked":false}]`)
+
+			status, body = get(server.URL + "/changelogs/app/versions?range=%5E1.0")
+			convey.So(status, convey.ShouldEqual, http.StatusOK)
+			convey.So(body, convey.ShouldEqual, `[{"version":"1.1.0","date":"2024-02-01T00:00:00Z","yanked":false},`+
+				`{"version":"1.0.0","date":"2024-01-01T00:00:00Z","yanked":false}]`)
+		})
+
+		convey.Convey("should get the version", func() {
+			status, body := get(server.URL + "/changelogs/app/versions/latest")
+			convey.So(status, convey.ShouldEqual, http.StatusOK)
+			convey.So(body, convey.ShouldEqual, `{"version":"1.1.0","date":"2024-02-01T00:00:00Z","yanked":false,"changes":[{"kind":"Fixed","entries":["fix bug"]}]}`)
+
+			status, _ = get(server.URL + "/changelogs/app/versions/2.0.0")
+			convey.So(status, convey.ShouldEqual, http.StatusNotFound)
+
+			status, _ = get(server.URL + "/changelogs/app/versions/invalid")
+			convey.So(status, convey.ShouldEqual, http.StatusBadRequest)
+		})
+
+		convey.Convey("should get the latest version", func() {
+			status, body := get(server.URL + "/changelogs/app/latest")
+			convey.So(status, convey.ShouldEqual, http.StatusOK)
+			convey.So(body, convey.ShouldEqual, `{"version":"1.1.0","date":"2024-02-01T00:00:00Z","yanked":false}`)
+		})
+
+		convey.Convey("should get the diff", func() {
+			status, body := get(server.URL + "/changelogs/app/diff")
+			convey.So(status, convey.ShouldEqual, http.StatusOK)
+			convey.So(body, convey.ShouldEqual, `{"from":"1.1.0","to":"Unreleased","changes":[{"kind":"Added","entries":["new feature"]}],"markdown":"### Added\n- new feature"}`)
+
+			status, body = get(server.URL + "/changelogs/app/diff?from=1.1.0&to=1.0.0")
+			convey.So(status, convey.ShouldEqual, http.StatusOK)
+			convey.So(body, convey.ShouldEqual, `{"from":"1.1.0","to":"1.0.0","changes":[{"kind":"Fixed","entries":["fix bug"]}],"markdown":"### Fixed\n- fix bug"}`)
+		})
+
+		convey.Convey("should get the direction", func() {
+			status, body := get(server.URL + "/changelogs/app/direction?from=latest&to=1.0.0")
+			convey.So(status, convey.ShouldEqual, http.StatusOK)
+			convey.So(body, convey.ShouldEqual, `{"from":"1.1.0","to":"1.0.0","direction":"ROLLBACK"}`)
+
+			status, _ = get(server.URL + "/changelogs/app/direction?from=1.0.0")
+			convey.So(status, convey.ShouldEqual, http.StatusBadRequest)
+
+			status, _ = get(server.URL + "/changelogs/app/direction?from=1.0.0&to=Unreleased")
+			convey.So(status, convey.ShouldEqual, http.StatusBadRequest)
+		})
+
+		convey.Convey("should fail on unknown routes and methods", func() {
+			status, _ := get(server.URL + "/changelogs/other/latest")
+			convey.So(status, convey.ShouldEqual, http.StatusNotFound)
+
+			status, _ = get(server.URL + "/changelogs/app/unknown")
+			convey.So(status, convey.ShouldEqual, http.StatusNotFound)
+
+			status, _ = get(server.URL + "/")
+			convey.So(status, convey.ShouldEqual, http.StatusNotFound)
+
+			resp, err := http.Post(server.URL+"/changelogs", "application/json", nil)
+			convey.So(err, convey.ShouldBeNil)
+			_ = resp.Body.Close()
+			convey.So(resp.StatusCode, convey.ShouldEqual, http.StatusMethodNotAllowed)
+		})
+
+		convey.Convey("should reload changed changelog", func() {
+			updated := "# Changelog\n\n## [2.0.0] - 2024-03-01\n### Removed\n- old API\n"
+			convey.So(os.WriteFile(path, []byte(updated), 0o644), convey.ShouldBeNil)
+			convey.So(os.Chtimes(path, time.Now(), time.Now().Add(time.Second)), convey.ShouldBeNil)
+
+			s.Reload()
+
+			_, body := get(server.URL + "/changelogs/app/latest")
+			convey.So(body, convey.ShouldEqual, `{"version":"2.0.0","date":"2024-03-01T00:00:00Z","yanked":false}`)
+		})
+
+		convey.Convey("should keep the previous changelog on errors", func() {
+			convey.So(os.Remove(path), convey.ShouldBeNil)
+
+			s.Reload()
+
+			_, body := get(server.URL + "/changelogs/app/latest")
+			convey.So(body, convey.ShouldEqual, `{"version":"1.1.0","date":"2024-02-01T00:00:00Z","yanked":false}`)
+		})
+
+		convey.Convey("should serve concurrent requests during reloads", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for j := 0; j < 20; j++ {
+						status, body := get(server.URL + "/changelogs/app/diff?from=1.0.0")
+						if status != http.StatusOK || !json.Valid([]byte(body)) {
+							t.Errorf("unexpected response %d: %s", status, body)
+						}
+					}
+				}()
+			}
+
+			for i := 0; i < 20; i++ {
+				_ = os.Chtimes(path, time.Now(), time.Now().Add(time.Duration(i+1)*time.Second))
+				s.Reload()
+			}
+
+			wg.Wait()
+		})
+	})
+}
+
+func TestNew(t *testing.T) {
+	convey.Convey("creating the server", t, func() {
+		convey.Convey("should require sources", func() {
+			_, err := New(nil, Options{})
+			convey.So(err, convey.ShouldEqual, ErrNoSources)
+		})
+
+		convey.Convey("should fail on missing files", func() {
+			_, err := New([]Source{{Name: "app", Path: filepath.Join(t.TempDir(), "missing.md")}}, Options{})
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
+
+func get(url string) (int, string) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return 0, err.Error()
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, _ := io.ReadAll(resp.Body)
+
+	return resp.StatusCode, string(bytes.TrimSpace(body))
+}
@@ -0,0 +1,151 @@
+package versionfiles
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// jsonUpdater replaces the string value by the path of keys of objects and indexes of arrays
+type jsonUpdater struct {
+	path []string
+}
+
+// jsonFrame is the object or the array being decoded
+type jsonFrame struct {
+	object bool
+	// key is the current key of the object or the index of the array
+	key string
+	// index is the number of decoded values of the array
+	index int
+	// wantKey reports whether the next token of the object is the key
+	wantKey bool
+}
+
+func (u jsonUpdater) Get(content []byte) (string, error) {
+	start, end, err := u.find(content)
+	if err != nil {
+		return "", err
+	}
+
+	var version string
+	if err := json.Unmarshal(content[start:end], &version); err != nil {
+		return "", err
+	}
+
+	return version, nil
+}
+
+func (u jsonUpdater) Set(content []byte, version string) ([]byte, error) {
+	start, end, err := u.find(content)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := json.Marshal(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return replace(content, start, end, value), nil
+}
+
+// find returns offsets of the string literal by the path
+func (u jsonUpdater) find(content []byte) (start, end int, err error) {
+	dec := json.NewDecoder(bytes.NewReader(content))
+	dec.UseNumber()
+
+	var stack []*jsonFrame
+
+	for {
+		offset := int(dec.InputOffset())
+
+		token, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return 0, 0, fmt.Errorf("%w: %s", ErrVersionNotFound, strings.Join(u.path, "."))
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+
+		var top *jsonFrame
+		if len(stack) > 0 {
+			top = stack[len(stack)-1]
+		}
+
+		if delim, ok := token.(json.Delim); ok && (delim == '}' || delim == ']') {
+			stack = stack[:len(stack)-1]
+			completeJSONValue(stack)
+			continue
+		}
+
+		if top != nil && top.object && top.wantKey {
+			top.key, _ = token.(string)
+			top.wantKey = false
+			continue
+		}
+
+		if top != nil && !top.object {
+			top.key = strconv.Itoa(top.index)
+		}
+
+		if delim, ok := token.(json.Delim); ok {
+			stack = append(stack, &jsonFrame{object: delim == '{', wantKey: delim == '{'})
+			continue
+		}
+
+		if u.matches(stack) {
+			if _, ok := token.(string); !ok {
+				return 0, 0, fmt.Errorf("%w: %v", ErrUnsupported, token)
+			}
+
+			start = offset + bytes.IndexByte(content[offset:], '"')
+
+			return start, int(dec.InputOffset()), nil
+		}
+
+		completeJSONValue(stack)
+	}
+}
+
+// matches reports whether keys of frames are the path
+func (u jsonUpdater) matches(stack []*jsonFrame) bool {
+	if len(stack) != len(u.path) {
+		return false
+	}
+
+	for i, frame := range stack {
+		if frame.key != u.path[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// completeJSONValue moves the current frame to the next key or index after the value
+func completeJSONValue(stack []*jsonFrame) {
+	if len(stack) == 0 {
+		return
+	}
+
+	top := stack[len(stack)-1]
+	if top.object {
+		top.wantKey = true
+	} else {
+		top.index++
+	}
+}
+
+// replace returns the content with bytes between offsets replaced by the value
+func replace(content []byte, start, end int, value []byte) []byte {
+	result := make([]byte, 0, len(content)-(end-start)+len(value))
+	result = append(result, content[:start]...)
+	result = append(result, value...)
+
+	return append(result, content[end:]...)
+}
@@ -0,0 +1,54 @@
+package versionfiles
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+var errRegexGroup = errors.New("regular expression should have a capturing group for the version")
+
+// regexUpdater replaces the first capturing group of the first match of the regular expression
+type regexUpdater struct {
+	re *regexp.Regexp
+}
+
+func newRegexUpdater(expr string) (regexUpdater, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return regexUpdater{}, err
+	}
+
+	if re.NumSubexp() < 1 {
+		return regexUpdater{}, errRegexGroup
+	}
+
+	return regexUpdater{re: re}, nil
+}
+
+func (u regexUpdater) Get(content []byte) (string, error) {
+	start, end, err := u.find(content)
+	if err != nil {
+		return "", err
+	}
+
+	return string(content[start:end]), nil
+}
+
+func (u regexUpdater) Set(content []byte, version string) ([]byte, error) {
+	start, end, err := u.find(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return replace(content, start, end, []byte(version)), nil
+}
+
+func (u regexUpdater) find(content []byte) (start, end int, err error) {
+	match := u.re.FindSubmatchIndex(content)
+	if match == nil || match[2] < 0 {
+		return 0, 0, fmt.Errorf("%w: %s", ErrVersionNotFound, u.re)
+	}
+
+	return match[2], match[3], nil
+}
@@ -0,0 +1,88 @@
+package versionfiles
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	reTOMLTable = regexp.MustCompile(`^\s*\[\[?\s*([^\]]+?)\s*\]\]?\s*(#.*)?$`)
+	reTOMLKey   = regexp.MustCompile(`^\s*([A-Za-z0-9_\-."' ]+?)\s*=\s*`)
+)
+
+// tomlUpdater replaces the string value by the key with its table (e.g. "project.version" is the key
+// "version" of the table [project] or the dotted key "project.version" of the root table)
+type tomlUpdater struct {
+	key string
+}
+
+func (u tomlUpdater) Get(content []byte) (string, error) {
+	lines := strings.SplitAfter(string(content), "\n")
+
+	i, start, end, err := u.find(lines)
+	if err != nil {
+		return "", err
+	}
+
+	return unquote(lines[i][start:end]), nil
+}
+
+func (u tomlUpdater) Set(content []byte, version string) ([]byte, error) {
+	lines := strings.SplitAfter(string(content), "\n")
+
+	i, start, end, err := u.find(lines)
+	if err != nil {
+		return nil, err
+	}
+
+	lines[i] = lines[i][:start] + requote(lines[i][start:end], version) + lines[i][end:]
+
+	return []byte(strings.Join(lines, "")), nil
+}
+
+// find returns the line and offsets of the string value by the key
+func (u tomlUpdater) find(lines []string) (line, start, end int, err error) {
+	table := ""
+
+	for i, text := range lines {
+		if match := reTOMLTable.FindStringSubmatch(text); match != nil {
+			table = normalizeTOMLKey(match[1])
+			continue
+		}
+
+		match := reTOMLKey.FindStringSubmatchIndex(text)
+		if match == nil {
+			continue
+		}
+
+		key := normalizeTOMLKey(text[match[2]:match[3]])
+		if table != "" {
+			key = table + "." + key
+		}
+
+		if key != u.key {
+			continue
+		}
+
+		start, end := scalarBounds(text, match[1], "#")
+		multiline := strings.HasPrefix(text[start:], `"""`) || strings.HasPrefix(text[start:], "'''")
+		if value := text[start:end]; multiline || len(value) < 2 || (value[0] != '"' && value[0] != '\'') {
+			return 0, 0, 0, fmt.Errorf("%w: %s is not a single-line string", ErrUnsupported, u.key)
+		}
+
+		return i, start, end, nil
+	}
+
+	return 0, 0, 0, fmt.Errorf("%w: %s", ErrVersionNotFound, u.key)
+}
+
+// normalizeTOMLKey returns the dotted key without quotes and spaces around dots
+func normalizeTOMLKey(key string) string {
+	parts := strings.Split(key, ".")
+	for i, part := range parts {
+		parts[i] = unquote(strings.TrimSpace(part))
+	}
+
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,153 @@
+// Package versionfiles keeps version strings of project files (package.json, Chart.yaml, pyproject.toml,
+// constants in the source code) in sync with the changelog. Files are edited in place: only the value
+// of the version is replaced, formatting and comments are kept
+package versionfiles
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	FormatJSON  Format = "json"
+	FormatYAML  Format = "yaml"
+	FormatTOML  Format = "toml"
+	FormatRegex Format = "regex"
+)
+
+var (
+	ErrInvalidSpec     = errors.New("invalid version file")
+	ErrUnknownFormat   = errors.New("unknown format of version file")
+	ErrVersionNotFound = errors.New("version is not found")
+	ErrUnsupported     = errors.New("value of the version is not supported")
+)
+
+// Format is the format of the version file
+type Format string
+
+// Updater reads and replaces the version in the content of the file
+type Updater interface {
+	Get(content []byte) (string, error)
+	Set(content []byte, version string) ([]byte, error)
+}
+
+// VersionFile is the file containing the version
+type VersionFile struct {
+	Path    string
+	Format  Format
+	Key     string
+	Updater Updater
+}
+
+// Mismatch is the version file which version differs from the expected one
+type Mismatch struct {
+	File    VersionFile
+	Version string
+	Err     error
+}
+
+// NewVersionFile returns the version file by the spec in format "path:format:key", e.g.:
+//   - "package.json:json:version" - JSON path with keys and indexes of arrays separated by dots
+//   - "Chart.yaml:yaml:appVersion" - YAML key, nested keys are separated by dots
+//   - "pyproject.toml:toml:project.version" - TOML key with its table
+//   - `version.go:regex:Version = "(.+)"` - regular expression, the first group of the first match is the version
+func NewVersionFile(spec string) (VersionFile, error) {
+	parts := strings.SplitN(spec, ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		return VersionFile{}, fmt.Errorf("%w: %s (expected path:format:key)", ErrInvalidSpec, spec)
+	}
+
+	file := VersionFile{Path: parts[0], Format: Format(parts[1]), Key: parts[2]}
+
+	switch file.Format {
+	case FormatJSON:
+		file.Updater = jsonUpdater{path: strings.Split(file.Key, ".")}
+	case FormatYAML:
+		file.Updater = yamlUpdater{key: file.Key}
+	case FormatTOML:
+		file.Updater = tomlUpdater{key: file.Key}
+	case FormatRegex:
+		updater, err := newRegexUpdater(file.Key)
+		if err != nil {
+			return VersionFile{}, fmt.Errorf("%w: %s: %v", ErrInvalidSpec, spec, err)
+		}
+		file.Updater = updater
+	default:
+		return VersionFile{}, fmt.Errorf("%w: %s", ErrUnknownFormat, file.Format)
+	}
+
+	return file, nil
+}
+
+func (f VersionFile) String() string {
+	return fmt.Sprintf("%s:%s:%s", f.Path, f.Format, f.Key)
+}
+
+// Read returns the version of the file
+func (f VersionFile) Read() (string, error) {
+	content, err := os.ReadFile(f.Path)
+	if err != nil {
+		return "", err
+	}
+
+	version, err := f.Updater.Get(content)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", f, err)
+	}
+
+	return version, nil
+}
+
+// Update replaces the version in the file keeping its permissions
+func (f VersionFile) Update(version string) error {
+	info, err := os.Stat(f.Path)
+	if err != nil {
+		return err
+	}
+
+	content, err := os.ReadFile(f.Path)
+	if err != nil {
+		return err
+	}
+
+	updated, err := f.Updater.Set(content, version)
+	if err != nil {
+		return fmt.Errorf("%s: %w", f, err)
+	}
+
+	return os.WriteFile(f.Path, updated, info.Mode().Perm())
+}
+
+// Update replaces versions of all files. Files are checked before writing, so no file is changed
+// if the version can't be found in any of them
+func Update(files []VersionFile, version string) error {
+	for _, file := range files {
+		if _, err := file.Read(); err != nil {
+			return err
+		}
+	}
+
+	for _, file := range files {
+		if err := file.Update(version); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Check returns files which versions differ from the version or can't be read
+func Check(files []VersionFile, version string) []Mismatch {
+	var mismatches []Mismatch
+
+	for _, file := range files {
+		actual, err := file.Read()
+		if err != nil || actual != version {
+			mismatches = append(mismatches, Mismatch{File: file, Version: actual, Err: err})
+		}
+	}
+
+	return mismatches
+}
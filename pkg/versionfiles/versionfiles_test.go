@@ -0,0 +1,170 @@
+package versionfiles
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestUpdaters(t *testing.T) {
+	convey.Convey("updating versions in content", t, func() {
+		cases := []struct {
+			name     string
+			spec     string
+			content  string
+			version  string
+			expected string
+		}{
+			{
+				name:     "json",
+				spec:     "package.json:json:version",
+				content:  "{\n  \"name\": \"app\",\n  \"config\": {\"version\": \"0.0.1\"},\n  \"version\" : \"1.0.0\",\n  \"private\": true\n}\n",
+				version:  "1.0.0",
+				expected: "{\n  \"name\": \"app\",\n  \"config\": {\"version\": \"0.0.1\"},\n  \"version\" : \"1.1.0\",\n  \"private\": true\n}\n",
+			},
+			{
+				name:     "nested json with arrays",
+				spec:     "app.json:json:packages.1.version",
+				content:  `{"packages": [{"version": "1.0.0"}, {"name": "b", "tags": [1, {"x": 2}], "version": "2.0.0"}]}`,
+				version:  "2.0.0",
+				expected: `{"packages": [{"version": "1.0.0"}, {"name": "b", "tags": [1, {"x": 2}], "version": "1.1.0"}]}`,
+			},
+			{
+				name:     "yaml",
+				spec:     "Chart.yaml:yaml:appVersion",
+				content:  "apiVersion: v2\nname: app\nversion: 0.1.0\nappVersion: \"1.0.0\" # the application\n",
+				version:  "1.0.0",
+				expected: "apiVersion: v2\nname: app\nversion: 0.1.0\nappVersion: \"1.1.0\" # the application\n",
+			},
+			{
+				name:     "nested yaml",
+				spec:     "values.yaml:yaml:image.tag",
+				content:  "tag: latest\nimage:\n  repository: app\n  tag: 1.0.0\nsidecar:\n  tag: 9.9.9\n",
+				version:  "1.0.0",
+				expected: "tag: latest\nimage:\n  repository: app\n  tag: 1.1.0\nsidecar:\n  tag: 9.9.9\n",
+			},
+			{
+				name:     "toml",
+				spec:     "pyproject.toml:toml:project.version",
+				content:  "version = \"0.0.0\"\n\n[project]\nname = \"app\"\nversion = '1.0.0'  # keep\n\n[tool.poetry]\nversion = \"3.0.0\"\n",
+				version:  "1.0.0",
+				expected: "version = \"0.0.0\"\n\n[project]\nname = \"app\"\nversion = '1.1.0'  # keep\n\n[tool.poetry]\nversion = \"3.0.0\"\n",
+			},
+			{
+				name:     "toml dotted key",
+				spec:     "pyproject.toml:toml:tool.poetry.version",
+				content:  "[tool]\npoetry.version = \"1.0.0\"\n",
+				version:  "1.0.0",
+				expected: "[tool]\npoetry.version = \"1.1.0\"\n",
+			},
+			{
+				name:     "regex",
+				spec:     `version.go:regex:Version = "v?([^"]+)"`,
+				content:  "package app\n\nconst Version = \"v1.0.0\"\n",
+				version:  "1.0.0",
+				expected: "package app\n\nconst Version = \"v1.1.0\"\n",
+			},
+		}
+
+		for _, c := range cases {
+			c := c
+			convey.Convey("should update "+c.name, func() {
+				file, err := NewVersionFile(c.spec)
+				convey.So(err, convey.ShouldBeNil)
+
+				version, err := file.Updater.Get([]byte(c.content))
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(version, convey.ShouldEqual, c.version)
+
+				updated, err := file.Updater.Set([]byte(c.content), "1.1.0")
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(string(updated), convey.ShouldEqual, c.expected)
+			})
+		}
+
+		convey.Convey("should fail on missing and unsupported values", func() {
+			for spec, content := range map[string]string{
+				"a.json:json:version":       `{"name": "app"}`,
+				"a.yaml:yaml:version":       "name: app\n",
+				"a.toml:toml:version":       "[project]\nversion = \"1.0.0\"\n",
+				"a.go:regex:Version = (.+)": "package app\n",
+			} {
+				file, err := NewVersionFile(spec)
+				convey.So(err, convey.ShouldBeNil)
+
+				_, err = file.Updater.Get([]byte(content))
+				convey.So(errors.Is(err, ErrVersionNotFound), convey.ShouldBeTrue)
+			}
+
+			for spec, content := range map[string]string{
+				"a.json:json:version":         `{"version": 1}`,
+				"a.yaml:yaml:version":         "version:\n  major: 1\n",
+				"a.toml:toml:project.version": "[project]\nversion = \"\"\"1.0.0\"\"\"\n",
+			} {
+				file, err := NewVersionFile(spec)
+				convey.So(err, convey.ShouldBeNil)
+
+				_, err = file.Updater.Get([]byte(content))
+				convey.So(errors.Is(err, ErrUnsupported), convey.ShouldBeTrue)
+			}
+		})
+
+		convey.Convey("should fail on invalid specs", func() {
+			_, err := NewVersionFile("package.json")
+			convey.So(errors.Is(err, ErrInvalidSpec), convey.ShouldBeTrue)
+
+			_, err = NewVersionFile("setup.ini:ini:version")
+			convey.So(errors.Is(err, ErrUnknownFormat), convey.ShouldBeTrue)
+
+			_, err = NewVersionFile("version.go:regex:Version = .+")
+			convey.So(errors.Is(err, ErrInvalidSpec), convey.ShouldBeTrue)
+		})
+	})
+}
+
+func TestFiles(t *testing.T) {
+	convey.Convey("updating and checking version files", t, func() {
+		dir := t.TempDir()
+
+		packageJSON := filepath.Join(dir, "package.json")
+		chart := filepath.Join(dir, "Chart.yaml")
+		convey.So(os.WriteFile(packageJSON, []byte(`{"version": "1.0.0"}`), 0o600), convey.ShouldBeNil)
+		convey.So(os.WriteFile(chart, []byte("version: 1.0.0\nappVersion: 1.0.0\n"), 0o644), convey.ShouldBeNil)
+
+		var files []VersionFile
+		for _, spec := range []string{packageJSON + ":json:version", chart + ":yaml:version", chart + ":yaml:appVersion"} {
+			file, err := NewVersionFile(spec)
+			convey.So(err, convey.ShouldBeNil)
+			files = append(files, file)
+		}
+
+		convey.Convey("should update all files", func() {
+			convey.So(Update(files, "1.1.0"), convey.ShouldBeNil)
+			convey.So(Check(files, "1.1.0"), convey.ShouldBeEmpty)
+
+			content, _ := os.ReadFile(chart)
+			convey.So(string(content), convey.ShouldEqual, "version: 1.1.0\nappVersion: 1.1.0\n")
+
+			info, _ := os.Stat(packageJSON)
+			convey.So(info.Mode().Perm(), convey.ShouldEqual, os.FileMode(0o600))
+		})
+
+		convey.Convey("should report mismatches", func() {
+			mismatches := Check(files, "1.1.0")
+			convey.So(mismatches, convey.ShouldHaveLength, 3)
+			convey.So(mismatches[0].Version, convey.ShouldEqual, "1.0.0")
+			convey.So(mismatches[0].Err, convey.ShouldBeNil)
+		})
+
+		convey.Convey("should not change files if any version is not found", func() {
+			missing, err := NewVersionFile(chart + ":yaml:image.tag")
+			convey.So(err, convey.ShouldBeNil)
+
+			convey.So(errors.Is(Update(append(files, missing), "1.1.0"), ErrVersionNotFound), convey.ShouldBeTrue)
+			convey.So(Check(files, "1.0.0"), convey.ShouldBeEmpty)
+		})
+	})
+}
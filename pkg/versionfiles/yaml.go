@@ -0,0 +1,126 @@
+package versionfiles
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// reYAMLKey matches "key: value" lines of block mappings
+var reYAMLKey = regexp.MustCompile(`^(\s*)([^\s#'"\-][^:#]*?|"[^"]*"|'[^']*')\s*:(\s+|$)`)
+
+// yamlUpdater replaces the scalar value by the key. Nested keys of block mappings are separated by dots
+// (e.g. "image.tag"), sequences and flow mappings are not supported
+type yamlUpdater struct {
+	key string
+}
+
+func (u yamlUpdater) Get(content []byte) (string, error) {
+	lines := strings.SplitAfter(string(content), "\n")
+
+	i, start, end, err := u.find(lines)
+	if err != nil {
+		return "", err
+	}
+
+	return unquote(lines[i][start:end]), nil
+}
+
+func (u yamlUpdater) Set(content []byte, version string) ([]byte, error) {
+	lines := strings.SplitAfter(string(content), "\n")
+
+	i, start, end, err := u.find(lines)
+	if err != nil {
+		return nil, err
+	}
+
+	lines[i] = lines[i][:start] + requote(lines[i][start:end], version) + lines[i][end:]
+
+	return []byte(strings.Join(lines, "")), nil
+}
+
+// find returns the line and offsets of the value by the key
+func (u yamlUpdater) find(lines []string) (line, start, end int, err error) {
+	type level struct {
+		indent int
+		key    string
+	}
+
+	var stack []level
+
+	for i, text := range lines {
+		trimmed := strings.TrimSpace(text)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || trimmed == "---" {
+			continue
+		}
+
+		match := reYAMLKey.FindStringSubmatchIndex(text)
+		if match == nil {
+			continue
+		}
+
+		indent := match[3] - match[2]
+		for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+		stack = append(stack, level{indent: indent, key: unquote(text[match[4]:match[5]])})
+
+		keys := make([]string, 0, len(stack))
+		for _, l := range stack {
+			keys = append(keys, l.key)
+		}
+
+		if strings.Join(keys, ".") != u.key {
+			continue
+		}
+
+		start, end := scalarBounds(text, match[1], "#")
+		if start == end {
+			return 0, 0, 0, fmt.Errorf("%w: %s is not a scalar", ErrUnsupported, u.key)
+		}
+
+		return i, start, end, nil
+	}
+
+	return 0, 0, 0, fmt.Errorf("%w: %s", ErrVersionNotFound, u.key)
+}
+
+// scalarBounds returns offsets of the scalar value (with quotes) starting from the offset of the line.
+// The value ends with the closing quote or before the comment
+func scalarBounds(line string, offset int, comment string) (start, end int) {
+	rest := strings.TrimRight(line[offset:], "\r\n")
+	start = offset + len(rest) - len(strings.TrimLeft(rest, " \t"))
+	rest = line[start : offset+len(rest)]
+
+	if rest != "" && (rest[0] == '"' || rest[0] == '\'') {
+		if closing := strings.IndexByte(rest[1:], rest[0]); closing >= 0 {
+			return start, start + closing + 2
+		}
+
+		return start, start
+	}
+
+	if i := strings.Index(rest, " "+comment); i >= 0 {
+		rest = rest[:i]
+	}
+
+	return start, start + len(strings.TrimRight(rest, " \t"))
+}
+
+// unquote returns the value without surrounding quotes
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+
+	return value
+}
+
+// requote returns the version with the same quotes as the previous value
+func requote(previous, version string) string {
+	if len(previous) >= 2 && (previous[0] == '"' || previous[0] == '\'') {
+		return string(previous[0]) + version + string(previous[0])
+	}
+
+	return version
+}
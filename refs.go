@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+type refItem struct {
+	changelog.Ref
+	Versions []changelog.VersionString `json:"versions"`
+	Entries  []string                  `json:"entries"`
+}
+
+func refsCommand(cl *changelog.Changelog) {
+	items := make([]*refItem, 0)
+	index := make(map[changelog.Ref]*refItem)
+
+	for _, entry := range cl.GetEntries(getDiffFilter(cl)) {
+		if kind != "" && !strings.EqualFold(string(entry.Kind), string(kinds.Resolve(kind))) {
+			continue
+		}
+
+		for _, ref := range entry.GetRefs(renderOptions.RefPatterns...) {
+			item, ok := index[ref]
+			if !ok {
+				item = &refItem{Ref: ref}
+				index[ref] = item
+				items = append(items, item)
+			}
+
+			if len(item.Versions) == 0 || item.Versions[len(item.Versions)-1] != entry.Version.GetVersion() {
+				item.Versions = append(item.Versions, entry.Version.GetVersion())
+			}
+			item.Entries = append(item.Entries, entry.Text)
+		}
+	}
+
+	if format == FormatJSON {
+		printJSON(items)
+	} else {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, item := range items {
+			versions := make([]string, 0, len(item.Versions))
+			for _, ver := range item.Versions {
+				versions = append(versions, string(ver))
+			}
+
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", item.ID, item.Kind, strings.Join(versions, ", "), item.URL)
+		}
+		_ = w.Flush()
+	}
+
+	if len(items) == 0 && failOnEmpty {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+	"github.com/s-larionov/changelog-cli/pkg/release"
+)
+
+const publishTimeout = 30 * time.Second
+
+// releasePayloadCommand prints the body of the request creating the release of the version or publishes it
+func releasePayloadCommand(cl *changelog.Changelog) {
+	ver := getTargetVersion(cl)
+
+	section, ok := cl.Versions[ver.GetVersion()]
+	if !ok {
+		Usage(fmt.Sprintf("Version %s does not exist in the changelog", ver.GetVersion()))
+		os.Exit(1)
+	}
+
+	payload, err := release.NewPayload(provider, section, release.Options{
+		TagPrefix:     tagPrefix,
+		Draft:         draft,
+		RenderOptions: renderOptions,
+	})
+	if err != nil {
+		Usage(fmt.Sprintf("Unable to build release payload: %v\n", err))
+		os.Exit(1)
+	}
+
+	if !publish {
+		printJSON(payload)
+		return
+	}
+
+	client := &release.Client{
+		Provider:   provider,
+		BaseURL:    getEnvDefault(apiURL, providerEnv[provider].apiURL),
+		Repository: getEnvDefault(repository, providerEnv[provider].repository),
+		Token:      os.Getenv(providerEnv[provider].token),
+		HTTPClient: &http.Client{Timeout: publishTimeout},
+	}
+
+	url, err := client.Publish(context.Background(), payload)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Unable to publish release: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println(url)
+}
+
+// providerEnv are names of environment variables of CI used by default for publishing releases
+var providerEnv = map[release.Provider]struct {
+	apiURL, repository, token string
+}{
+	release.ProviderGitHub: {apiURL: "GITHUB_API_URL", repository: "GITHUB_REPOSITORY", token: "GITHUB_TOKEN"},
+	release.ProviderGitLab: {apiURL: "CI_API_V4_URL", repository: "CI_PROJECT_ID", token: "GITLAB_TOKEN"},
+}
+
+// getEnvDefault returns the value or the environment variable if the value is empty
+func getEnvDefault(value, env string) string {
+	if value != "" {
+		return value
+	}
+
+	return os.Getenv(env)
+}
@@ -0,0 +1,12 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+// renderCommand prints the whole changelog for displaying (e.g. on release pages)
+func renderCommand(cl *changelog.Changelog) {
+	fmt.Println(cl.Render(renderOptions))
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+	"github.com/s-larionov/changelog-cli/pkg/rpm"
+)
+
+// rpmCommand prints released versions as %changelog entries or replaces %changelog section of the spec file
+func rpmCommand(cl *changelog.Changelog) {
+	output, err := rpm.Render(getFilteredSections(cl), rpm.Options{Packager: maintainer, Release: revision})
+	if err != nil {
+		Usage(fmt.Sprintf("Unable to render rpm changelog: %v\n", err))
+		os.Exit(1)
+	}
+
+	if specPath == "" {
+		fmt.Print(output)
+		return
+	}
+
+	info, err := os.Stat(specPath)
+	if err != nil {
+		Usage(fmt.Sprintf("Unable to read spec file: %v\n", err))
+		os.Exit(1)
+	}
+
+	spec, err := os.ReadFile(specPath)
+	if err != nil {
+		Usage(fmt.Sprintf("Unable to read spec file: %v\n", err))
+		os.Exit(1)
+	}
+
+	spec, err = rpm.ReplaceChangelog(spec, output)
+	if err != nil {
+		Usage(fmt.Sprintf("Unable to replace %%changelog section: %v\n", err))
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(specPath, spec, info.Mode().Perm()); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Unable to write spec file: %v\n", err)
+		os.Exit(1)
+	}
+}
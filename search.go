@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+type searchResult struct {
+	Version changelog.VersionString `json:"version"`
+	Date    *time.Time              `json:"date,omitempty"`
+	Kind    changelog.ChangesKind   `json:"kind"`
+	Entry   string                  `json:"entry"`
+}
+
+func searchCommand(cl *changelog.Changelog) {
+	re, err := regexp.Compile(query)
+	if err != nil {
+		Usage(fmt.Sprintf("Wrong format for 'query' regular expression: %v\n", err))
+		os.Exit(1)
+	}
+
+	results := make([]searchResult, 0)
+	for _, entry := range cl.GetEntries(versionFilter) {
+		if kind != "" && !strings.EqualFold(string(entry.Kind), string(kinds.Resolve(kind))) {
+			continue
+		}
+
+		if !re.MatchString(entry.Text) {
+			continue
+		}
+
+		result := searchResult{
+			Version: entry.Version.GetVersion(),
+			Kind:    entry.Kind,
+			Entry:   entry.Text,
+		}
+		if date := entry.Version.GetDate(); !date.IsZero() {
+			result.Date = &date
+		}
+
+		results = append(results, result)
+	}
+
+	if format == FormatJSON {
+		printJSON(results)
+	} else {
+		for _, result := range results {
+			date := ""
+			if result.Date != nil {
+				date = " - " + result.Date.Format(dateLayout)
+			}
+
+			fmt.Printf("[%s]%s %s: %s\n", result.Version, date, result.Kind, result.Entry)
+		}
+	}
+
+	if len(results) == 0 && failOnEmpty {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/s-larionov/changelog-cli/pkg/server"
+)
+
+// DefaultSourceName is the name of the changelog passed by 'file' param in serve command
+const DefaultSourceName = "default"
+
+const shutdownTimeout = 10 * time.Second
+
+// serveCommand serves queries of changelogs over HTTP until SIGINT or SIGTERM
+func serveCommand() {
+	s, err := server.New(serveSources, server.Options{
+		ReloadInterval: reloadInterval,
+		ParseOptions:   readOptions,
+		RenderOptions:  renderOptions,
+	})
+	if err != nil {
+		Usage(fmt.Sprintf("Unable to read changelog file: %v\n", err))
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go s.Run(ctx)
+
+	httpServer := &http.Server{Addr: addr, Handler: s, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		_ = httpServer.Shutdown(shutdownCtx)
+	}()
+
+	_, _ = fmt.Fprintf(os.Stderr, "Serving %d changelog(s) on %s\n", len(serveSources), addr)
+
+	if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		_, _ = fmt.Fprintf(os.Stderr, "Unable to serve: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// parseServeSources fills sources of serve command by 'changelog' params or by 'file' param
+func parseServeSources(values []string) {
+	if len(values) == 0 {
+		values = []string{DefaultSourceName + "=" + filepath}
+	}
+
+	for _, value := range values {
+		name, path, ok := strings.Cut(value, "=")
+		if !ok || strings.TrimSpace(name) == "" || strings.TrimSpace(path) == "" {
+			Usage(fmt.Sprintf("Wrong format for 'changelog' %s\n", value))
+			os.Exit(1)
+		}
+
+		if strings.EqualFold(path, UseSTDIN) {
+			Usage("Changelogs can't be read from STDIN in serve command")
+			os.Exit(1)
+		}
+
+		serveSources = append(serveSources, server.Source{Name: strings.TrimSpace(name), Path: strings.TrimSpace(path)})
+	}
+}
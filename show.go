@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+type showResult struct {
+	Version     changelog.VersionString `json:"version"`
+	Date        *time.Time              `json:"date,omitempty"`
+	Yanked      bool                    `json:"yanked"`
+	Description string                  `json:"description,omitempty"`
+	Changes     []showKind              `json:"changes"`
+}
+
+type showKind struct {
+	Kind    changelog.ChangesKind `json:"kind"`
+	Entries []string              `json:"entries"`
+}
+
+func showCommand(cl *changelog.Changelog) {
+	ver := getTargetVersion(cl)
+
+	section, ok := cl.Versions[ver.GetVersion()]
+	if !ok {
+		Usage(fmt.Sprintf("Version %s does not exist in the changelog", ver.GetVersion()))
+		os.Exit(1)
+	}
+
+	switch format {
+	case FormatJSON:
+		printJSON(newShowResult(section))
+	case FormatText:
+		fmt.Println(renderVersionText(section))
+	default:
+		fmt.Println(section.ToMarkdown())
+	}
+}
+
+func newShowResult(section changelog.VersionChanges) showResult {
+	result := showResult{
+		Version:     section.Version.GetVersion(),
+		Yanked:      section.Version.IsYanked(),
+		Description: section.Description,
+		Changes:     make([]showKind, 0),
+	}
+
+	if date := section.Version.GetDate(); !date.IsZero() {
+		result.Date = &date
+	}
+
+	for _, kind := range section.Changes.GetKinds() {
+		result.Changes = append(result.Changes, showKind{Kind: kind, Entries: section.Changes.GetEntries(kind)})
+	}
+
+	return result
+}
+
+// renderVersionText renders the version section as plain text (e.g. for annotations of git tags)
+func renderVersionText(section changelog.VersionChanges) string {
+	lines := []string{string(section.Version.GetVersion())}
+	if date := section.Version.GetDate(); !date.IsZero() {
+		lines[0] += " (" + date.Format(dateLayout) + ")"
+	}
+	if section.Version.IsYanked() {
+		lines[0] += " [YANKED]"
+	}
+
+	if section.Description != "" {
+		lines = append(lines, "", section.Description)
+	}
+
+	for _, kind := range section.Changes.GetKinds() {
+		lines = append(lines, "", string(kind)+":")
+		for _, entry := range section.Changes.GetEntries(kind) {
+			lines = append(lines, "  * "+entry)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
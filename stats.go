@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+func statsCommand(cl *changelog.Changelog) {
+	stats := cl.GetStats(versionFilter, changelog.StatsPeriod(period))
+
+	if format == FormatJSON {
+		printJSON(stats)
+		return
+	}
+
+	fmt.Printf("Releases: %d\n", stats.Releases)
+	fmt.Printf("Median days between releases: %.1f\n", stats.MedianDaysBetweenReleases)
+	if stats.LongestGap != nil {
+		fmt.Printf("Longest gap: %d days (%s → %s)\n", stats.LongestGap.Days, stats.LongestGap.From, stats.LongestGap.To)
+	}
+
+	bumps := make([]string, 0, 3)
+	for _, majority := range []changelog.ChangesMajority{changelog.PatchChanges, changelog.MinorChanges, changelog.MajorChanges} {
+		share := 0.0
+		if stats.Releases > 0 {
+			share = float64(stats.Bumps[majority]) * 100 / float64(stats.Releases)
+		}
+		bumps = append(bumps, fmt.Sprintf("%s %d (%.0f%%)", majority, stats.Bumps[majority], share))
+	}
+	fmt.Printf("Bumps: %s\n", strings.Join(bumps, ", "))
+	fmt.Println()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	_, _ = fmt.Fprintln(w, "Period\tReleases")
+	for _, p := range stats.ReleasesPerPeriod {
+		_, _ = fmt.Fprintf(w, "%s\t%d\n", p.Period, p.Releases)
+	}
+	_, _ = fmt.Fprintln(w)
+
+	header := []string{"Version", "Date", "Bump"}
+	for _, kind := range changelog.OrderedKinds {
+		header = append(header, string(kind))
+	}
+	_, _ = fmt.Fprintln(w, strings.Join(header, "\t"))
+
+	for _, ver := range stats.Versions {
+		date := "-"
+		if ver.Date != nil {
+			date = ver.Date.Format("2006-01-02")
+		}
+
+		row := []string{string(ver.Version), date, ver.Bump.String()}
+		for _, kind := range changelog.OrderedKinds {
+			row = append(row, fmt.Sprintf("%d", ver.Entries[kind]))
+		}
+		_, _ = fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+
+	_ = w.Flush()
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+)
+
+func unreleaseCommand(cl *changelog.Changelog) {
+	ver := getTargetVersion(cl)
+
+	links, hasLinks := getCompareLinks(cl)
+
+	if !force {
+		// Tags are named like in compare links: by the detected prefix or -tag-prefix
+		tags := changelog.CompareLinks{TagPrefix: tagPrefix}
+		if hasLinks {
+			tags = links
+		}
+
+		exists, err := gitTagExists(tags.GetTag(ver))
+		if err != nil {
+			Usage(fmt.Sprintf("Unable to check git tags (use -force to skip the check): %v\n", err))
+			os.Exit(1)
+		}
+
+		if exists {
+			Usage(fmt.Sprintf("Version %s is already tagged in git, use -force to unrelease it anyway", ver.GetVersion()))
+			os.Exit(1)
+		}
+	}
+
+	if err := cl.Unrelease(ver); err != nil {
+		Usage(fmt.Sprintf("Unable to unrelease the version: %v", err))
+		os.Exit(1)
+	}
+
+	if hasLinks {
+		cl.UpdateLinks(links, changelog.Unreleased)
+	}
+
+	printChangelog(cl)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func Usage(msg string) {
+	if msg != "" {
+		fmt.Println(msg)
+		fmt.Println()
+	}
+
+	fmt.Printf("Usage of %s:\n", os.Args[0])
+	fmt.Println("  Show diff between versions:")
+	fmt.Printf("    %s -command=diff [-file=CHANGELOG.md] [-from=latest] [-to=Unreleased]\n", os.Args[0])
+	fmt.Printf("    %s -command=diff [-file=CHANGELOG.md] [-range=1.2.0..HEAD] [-since=2024-01-01] [-until=]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Bump new version:")
+	fmt.Printf("    %s -command=bump [-file=CHANGELOG.md] [-bump=auto] [-version=] [-repository-url=] [-tag-prefix=v] [-version-file=package.json:json:version]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Init new changelog:")
+	fmt.Printf("    %s -command=init\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Show release direction (UPGRADE, ROLLBACK, REDEPLOY):")
+	fmt.Printf("    %s -command=direction [-file=CHANGELOG.md] -from=0.1.4 -to=2.3.4\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Get the latest released version from the CHANGELOG:")
+	fmt.Printf("    %s -command=latest_version [-file=CHANGELOG.md]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Check changes of the changelog in a merge request:")
+	fmt.Printf("    %s -command=check-pr [-file=CHANGELOG.md] -base=origin/main [-release-branch=^release/] [-branch=]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Compare versions and entries of two changelogs:")
+	fmt.Printf("    %s -command=compare [-file=CHANGELOG.md] -other=OTHER.md\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Merge changelogs as git merge driver (the result is written to the %A file):")
+	fmt.Printf("    %s merge-driver %%O %%A %%B\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Show statistics of releases:")
+	fmt.Printf("    %s -command=stats [-file=CHANGELOG.md] [-period=quarter] [-from=] [-to=] [-since=] [-until=] [-format=text]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Search entries by regular expression:")
+	fmt.Printf("    %s -command=search [-file=CHANGELOG.md] -query=ISSUE-123 [-kind=Fixed] [-from=] [-to=] [-format=text]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Show the section of the version:")
+	fmt.Printf("    %s -command=show [-file=CHANGELOG.md] [-version=latest] [-format=markdown]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Move entries of the latest (or specified) release back to Unreleased:")
+	fmt.Printf("    %s -command=unrelease [-file=CHANGELOG.md] [-version=latest] [-force]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Edit released versions and entries:")
+	fmt.Printf("    %s -command=rename [-file=CHANGELOG.md] -version=1.3.0 -new-version=2.0.0\n", os.Args[0])
+	fmt.Printf("    %s -command=redate [-file=CHANGELOG.md] -version=1.3.0 -date=2024-01-29\n", os.Args[0])
+	fmt.Printf("    %s -command=move-entry [-file=CHANGELOG.md] -version=1.3.0 -kind=Changed -entry=TEXT [-to-version=] [-to-kind=]\n", os.Args[0])
+	fmt.Printf("    %s -command=delete-entry [-file=CHANGELOG.md] -version=1.3.0 -kind=Changed -entry=TEXT\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Show lifecycle of deprecations (pending, removed, removed without deprecation):")
+	fmt.Printf("    %s -command=deprecations [-file=CHANGELOG.md] [-format=text]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Render the changelog for displaying (with grouped breaking changes):")
+	fmt.Printf("    %s -command=render [-file=CHANGELOG.md] [-group-breaking=true] [-locale=]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  List references to issues and pull requests mentioned between versions:")
+	fmt.Printf("    %s -command=refs [-file=CHANGELOG.md] [-from=latest] [-to=Unreleased] [-kind=] [-ref-pattern=kind=regexp] [-format=text]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Format the changelog (and optionally turn references into links):")
+	fmt.Printf("    %s -command=fmt [-file=CHANGELOG.md] [-normalize] [-linkify -link-template=https://tracker.local/browse/{id}]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Convert changelog generated by conventional-changelog or exported GitHub releases:")
+	fmt.Printf("    %s -command=convert -from-format=conventional|github-releases [-file=CHANGELOG.md]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Render released versions as debian/changelog:")
+	fmt.Printf("    %s -command=debian [-file=CHANGELOG.md] -package=NAME -maintainer=\"Name <email>\" [-distribution=unstable] [-revision=1] [-urgency=Security=high] [-range=]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Render released versions as changelog section of RPM spec (or replace it in the spec file):")
+	fmt.Printf("    %s -command=rpm [-file=CHANGELOG.md] -maintainer=\"Name <email>\" [-revision=1] [-spec=package.spec] [-range=]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Print the payload of the release for GitHub or GitLab API (or publish it):")
+	fmt.Printf("    %s -command=release-payload [-file=CHANGELOG.md] [-provider=github] [-version=latest] [-tag-prefix=v] [-draft]\n", os.Args[0])
+	fmt.Printf("    %s -command=release-payload [-file=CHANGELOG.md] [-provider=github] [-version=latest] -publish [-repository=owner/repo] [-api-url=]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Post changes between versions (or changes of the version) to Slack, Mattermost or Teams:")
+	fmt.Printf("    %s -command=notify [-file=CHANGELOG.md] -target=slack|mattermost|teams [-webhook=URL] [-from=latest] [-to=Unreleased] [-title=]\n", os.Args[0])
+	fmt.Printf("    %s -command=notify [-file=CHANGELOG.md] -target=slack|mattermost|teams [-webhook=URL] -version=1.2.0 [-title=]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Serve changelogs as JSON API (reloaded on changes of files):")
+	fmt.Printf("    %s -command=serve [-file=CHANGELOG.md] [-addr=:8080] [-reload-interval=2s]\n", os.Args[0])
+	fmt.Printf("    %s -command=serve -changelog=app=CHANGELOG.md -changelog=lib=lib/CHANGELOG.md [-addr=:8080]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  Check that versions of project files match the latest version of the changelog:")
+	fmt.Printf("    %s -command=check-version-files [-file=CHANGELOG.md] -version-file=package.json:json:version [-version-file=Chart.yaml:yaml:appVersion]\n", os.Args[0])
+	fmt.Println()
+	fmt.Println("  List versions:")
+	fmt.Printf("    %s -command=list [-file=CHANGELOG.md] [-constraint=\">=1.2, <2.0.0\"] [-since=] [-until=] [-prerelease=true] [-sort=desc] [-format=text]\n", os.Args[0])
+	fmt.Println()
+
+	fmt.Println("Parameters:")
+	flag.PrintDefaults()
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/s-larionov/changelog-cli/pkg/changelog"
+	"github.com/s-larionov/changelog-cli/pkg/versionfiles"
+)
+
+// checkVersionFilesCommand verifies that versions of all version files match the latest version of the changelog
+func checkVersionFilesCommand(cl *changelog.Changelog) {
+	latest := string(cl.GetLatestVersion().GetVersion())
+
+	mismatches := versionfiles.Check(versionFiles, latest)
+	for _, mismatch := range mismatches {
+		if mismatch.Err != nil {
+			fmt.Printf("%s: %v\n", mismatch.File.Path, mismatch.Err)
+			continue
+		}
+
+		fmt.Printf("%s: %s is %s, expected %s\n", mismatch.File.Path, mismatch.File.Key, mismatch.Version, latest)
+	}
+
+	if len(mismatches) > 0 {
+		os.Exit(1)
+	}
+}
+
+// updateVersionFiles replaces versions of all version files by the released version
+func updateVersionFiles(version changelog.Version) {
+	if err := versionfiles.Update(versionFiles, string(version.GetVersion())); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Unable to update version files: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// parseVersionFiles fills version files by 'version-file' params
+func parseVersionFiles(specs []string) {
+	for _, spec := range specs {
+		file, err := versionfiles.NewVersionFile(spec)
+		if err != nil {
+			Usage(fmt.Sprintf("Wrong format for 'version-file': %v\n", err))
+			os.Exit(1)
+		}
+
+		versionFiles = append(versionFiles, file)
+	}
+}